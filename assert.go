@@ -20,28 +20,81 @@ var (
 	compareResults = struct{ NotMatch, Match, MatchAndStop int }{-1, 0, 1}
 )
 
-//typeRequired 类型要求断言
+//typeRequired 类型要求断言（使用全局严格模式设置）
 func typeRequired(target reflect.Type, estimate ...reflect.Type) error {
+	return typeRequiredWith(strictTypes(), target, estimate...)
+}
+
+//typeRequiredWith 类型要求断言，不匹配时错误会指出同输入数量签名中首个不匹配的参数
+func typeRequiredWith(strict bool, target reflect.Type, estimate ...reflect.Type) error {
 	for _, t := range estimate {
-		if result := typeCompare(target, t); result != compareResults.NotMatch {
+		if result := typeCompare(target, t, strict); result != compareResults.NotMatch {
 			return nil
 		}
 	}
-	types := From(estimate).List().Select(func(x reflect.Type) string { return x.String() }).Slice().([]string)
 	if len(estimate) > 0 {
-		return throwTypeNotCompatiable(strings.Join(types, "', '"), target)
+		var err = throwTypeNotCompatiable(joinTypeNames(estimate), target).(*TypeNotCompatible)
+		err.Candidates = estimate
+		err.Parameter, err.Expected = mismatchedParameter(target, strict, estimate...)
+		return err
 	}
 	return nil
 }
 
+//inputCompare 函数第 position 个输入的类型匹配
+//非严格模式下，第一个输入（方法表达式的接收者）还接受有方法的类型的值与其指针之间的匹配（调用时传入副本的指针或解引用），
+//以支持 (*T).Method 与 T.Method 形式的方法表达式。
+func inputCompare(target reflect.Type, estimate reflect.Type, strict bool, position int) int {
+	if !strict && position == 0 && estimate != types.AnyType && estimate != types.AnyTypes {
+		if target == reflect.PtrTo(estimate) && target.NumMethod() > 0 ||
+			estimate.Kind() == reflect.Ptr && estimate.Elem() == target && estimate.NumMethod() > 0 {
+			return compareResults.Match
+		}
+	}
+	return typeCompare(target, estimate, strict)
+}
+
+//parameterType 函数第 index 个输入的类型，可变参数的位置及其后为元素类型
+func parameterType(t reflect.Type, index int) reflect.Type {
+	if last := t.NumIn() - 1; t.IsVariadic() && index >= last {
+		return t.In(last).Elem()
+	}
+	return t.In(index)
+}
+
+//mismatchedParameter 查找与目标函数输入数量相同的签名中首个不匹配的输入位置及其期望类型，找不到时返回 -1
+func mismatchedParameter(target reflect.Type, strict bool, estimate ...reflect.Type) (int, reflect.Type) {
+	if target.Kind() != reflect.Func {
+		return -1, nil
+	}
+	for _, t := range estimate {
+		if t.Kind() != reflect.Func || t.NumIn() != target.NumIn() && !(target.IsVariadic() && !t.IsVariadic() && t.NumIn() >= target.NumIn()) {
+			continue
+		}
+		for i := 0; i < t.NumIn(); i++ {
+			if inputCompare(parameterType(target, i), t.In(i), strict, i) == compareResults.NotMatch {
+				return i, t.In(i)
+			}
+		}
+	}
+	return -1, nil
+}
+
 //typeCompare 类型匹配
-func typeCompare(target reflect.Type, estimate reflect.Type) int {
+//严格模式下只接受相同或可赋值的类型，否则接受可转换的类型。
+func typeCompare(target reflect.Type, estimate reflect.Type, strict bool) int {
 	//对于任意数量的匹配，那么返回匹配且终止后续匹配
 	if estimate == types.AnyTypes {
 		return compareResults.MatchAndStop
-	} else if estimate == types.AnyType || target.Kind() == reflect.Interface {
+	} else if estimate == types.AnyType {
 		//如果任意类型匹配，则返回匹配
 		return compareResults.Match
+	} else if target.Kind() == reflect.Interface {
+		//接口类型需要给出的类型实现该接口
+		if estimate.Implements(target) {
+			return compareResults.Match
+		}
+		return compareResults.NotMatch
 	} else if target.Kind() != estimate.Kind() {
 		//类型不同不用匹配
 		return compareResults.NotMatch
@@ -50,26 +103,52 @@ func typeCompare(target reflect.Type, estimate reflect.Type) int {
 	case reflect.Func:
 		var ein, tin = estimate.NumIn(), target.NumIn()
 		var eout, tout = estimate.NumOut(), target.NumOut()
-		var cursor int
+		//可变参数的签名只匹配可变参数位置相同的函数
+		if estimate.IsVariadic() && (!target.IsVariadic() || tin != ein) {
+			return compareResults.NotMatch
+		}
+		//目标函数的可变参数可以接受零到多个输入
+		var spread, required = target.IsVariadic() && !estimate.IsVariadic(), tin
+		if spread {
+			required--
+		}
+		var cursor, rest = 0, false
 		for ; cursor < ein; cursor++ {
 			//如果是往后任意类型，那么直接跳过后续输入匹配
 			if estimate.In(cursor) == types.AnyTypes {
+				rest = true
 				break
 			}
 			//如果目标函数输入项缺失，则不匹配
-			if cursor >= tin {
+			if !spread && cursor >= tin {
 				return compareResults.NotMatch
 			}
-			//执行平凡类型匹配
-			var result = typeCompare(target.In(cursor), estimate.In(cursor))
+			//执行输入类型匹配（展开可变参数时使用其元素类型）
+			var in reflect.Type
+			if spread {
+				in = parameterType(target, cursor)
+			} else {
+				in = target.In(cursor)
+			}
+			var result = inputCompare(in, estimate.In(cursor), strict, cursor)
 			if result == compareResults.NotMatch {
 				//不匹配立即返回
 				return compareResults.NotMatch
 			} else if result == compareResults.MatchAndStop {
 				//匹配且终止则跳出
+				rest = true
 				break
 			}
 		}
+		//目标函数存在多余的输入项，则不匹配（除非签名以 AnyRest 结尾）
+		//调用时只会按签名传入参数，接受多余的输入会让调用在运行时因参数不足而失败
+		if !rest && required > cursor {
+			return compareResults.NotMatch
+		}
+		//展开的可变参数至少要接收一个输入，否则其元素类型未经校验（如 func(...string) 匹配 func()）
+		if spread && !rest && cursor <= required {
+			return compareResults.NotMatch
+		}
 		//输出匹配
 		for cursor = 0; cursor < eout; cursor++ {
 			//如果是往后任意类型，那么直接跳过后续输出匹配
@@ -81,7 +160,7 @@ func typeCompare(target reflect.Type, estimate reflect.Type) int {
 				return compareResults.NotMatch
 			}
 			//执行平凡类型匹配
-			var result = typeCompare(target.Out(cursor), estimate.Out(cursor))
+			var result = typeCompare(target.Out(cursor), estimate.Out(cursor), strict)
 			if result != compareResults.Match {
 				//如果匹配且终止、不匹配那么直接返回
 				return result
@@ -89,16 +168,35 @@ func typeCompare(target reflect.Type, estimate reflect.Type) int {
 		}
 		return compareResults.Match
 	case reflect.Slice, reflect.Array:
-		return typeCompare(target.Elem(), estimate.Elem())
+		return typeCompare(target.Elem(), estimate.Elem(), strict)
 	default:
-		if estimate == target || target.ConvertibleTo(estimate) {
+		if estimate == target || target.AssignableTo(estimate) || estimate.AssignableTo(target) {
+			return compareResults.Match
+		} else if !strict && target.ConvertibleTo(estimate) {
 			return compareResults.Match
 		}
 		return compareResults.NotMatch
 	}
 }
 
+//valueCompare 值相等判定
+//依次使用 EqualsTo* 钩子（包括反向）、注册的相等比较函数，类型相同时再按 == 或相等判定策略比较。
 func valueCompare(v1, v2 reflect.Value) bool {
+	return valueCompareWith(v1, v2, nil)
+}
+
+//valueCompareWith 值相等判定，visited 记录结构比较中正在比较的切片与映射，用于处理自引用的值
+func valueCompareWith(v1, v2 reflect.Value, visited map[visit]bool) bool {
+	//拆箱接口以便使用实际类型的比较钩子
+	if v1.Kind() == reflect.Interface && !v1.IsNil() {
+		v1 = v1.Elem()
+	}
+	if v2.Kind() == reflect.Interface && !v2.IsNil() {
+		v2 = v2.Elem()
+	}
+	if !v1.IsValid() || !v2.IsValid() {
+		return isNil(v1) && isNil(v2)
+	}
 	t1, t2 := v1.Type(), v2.Type()
 	if function := getCompareHook(t1, t2); function != nil {
 		if call(*function, v1, v2)[0].Bool() {
@@ -109,6 +207,19 @@ func valueCompare(v1, v2 reflect.Value) bool {
 			return true
 		}
 	}
+	//注册的相等比较函数
+	if function := getEqualityComparer(t1, t2); function != nil {
+		return call(*function, v1, v2)[0].Bool()
+	} else if function = getEqualityComparer(t2, t1); function != nil {
+		return call(*function, v2, v1)[0].Bool()
+	}
+	if t1 != t2 {
+		return false
+	}
+	switch t1.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map, reflect.Struct, reflect.Func:
+		return fallbackEquals(v1, v2, visited)
+	}
 	return v1.Interface() == v2.Interface()
 }
 
@@ -169,11 +280,163 @@ func getBlurMatchFunction(name string) func(string) bool {
 }
 
 //call 转换类型并调用函数
+//函数发生的 panic 会包装为 LambdaPanicked（本包抛出的错误除外）。
 func call(f reflect.Value, in ...reflect.Value) []reflect.Value {
+	return invoke("", f, in...)
+}
+
+//invoke 与 call 相同，包装的 LambdaPanicked 会记录所属的操作
+func invoke(operator string, f reflect.Value, in ...reflect.Value) []reflect.Value {
 	t := f.Type()
-	var args = make([]reflect.Value, t.NumIn())
+	defer guard(operator, t)
+	var numin = t.NumIn()
+	if t.IsVariadic() && len(in) >= numin-1 {
+		//可变参数按给出的输入逐个传入
+		numin = len(in)
+	}
+	var args = in[:numin]
 	for index := range args {
-		args[index] = in[index].Convert(t.In(index))
+		if argt := parameterType(t, index); in[index].Type() != argt {
+			//类型不一致时才复制并转换参数
+			if &args[0] == &in[0] {
+				args = append([]reflect.Value(nil), args...)
+			}
+			args[index] = convertArgument(in[index], argt)
+		}
 	}
 	return f.Call(args)
 }
+
+//convertArgument 将参数转换为函数输入类型，值与指针之间自动取址（总是使用副本，不会修改集合中的元素）或解引用
+//解引用空指针时抛出 ArgumentIsInvalid。
+func convertArgument(v reflect.Value, t reflect.Type) reflect.Value {
+	if t.Kind() == reflect.Ptr && t.Elem() == v.Type() {
+		var value = reflect.New(v.Type())
+		value.Elem().Set(v)
+		return value
+	} else if v.Kind() == reflect.Ptr && v.Type().Elem() == t {
+		if v.IsNil() {
+			panic(throwArgumentIsInvalid(v.Type().String(), nil))
+		}
+		return v.Elem()
+	}
+	return v.Convert(t)
+}
+
+//guard 将函数发生的 panic 包装为 LambdaPanicked，仅可用于 defer
+func guard(operator string, t reflect.Type) {
+	if r := recover(); r != nil {
+		if isCollectionError(r) {
+			panic(r)
+		}
+		var err = throwLambdaPanicked(t, r)
+		err.(*LambdaPanicked).Operator = operator
+		panic(err)
+	}
+}
+
+//isNumber 判断是否为整数或浮点类型
+func isNumber(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+//convertTo 将任意对象转换为目标类型的值（nil 转换为零值，使用全局严格模式设置）
+func convertTo(obj interface{}, t reflect.Type) reflect.Value {
+	return convertToWith(strictTypes(), obj, t)
+}
+
+//convertToWith 将任意对象转换为目标类型的值（nil 转换为零值）
+//严格模式下只接受可赋值的对象，否则接受可转换的对象，但不接受丢失信息的转换：
+//转换为整数时的小数部分、溢出、符号变化或 NaN，以及整数到字符串的转换（按码点得到字符）。
+func convertToWith(strict bool, obj interface{}, t reflect.Type) reflect.Value {
+	if obj == nil {
+		return reflect.Zero(t)
+	}
+	var value = reflect.ValueOf(obj)
+	if strict && !value.Type().AssignableTo(t) || !value.Type().ConvertibleTo(t) {
+		panic(throwTypeNotCompatiable(t.String(), value.Type()))
+	}
+	if t.Kind() == reflect.String && isNumber(value.Type()) {
+		panic(throwTypeNotCompatiable(t.String(), value.Type()))
+	}
+	var converted = value.Convert(t)
+	if isNumber(value.Type()) && isNumber(t) && !lossless(value, converted) {
+		panic(throwTypeNotCompatiable(t.String(), value.Type()))
+	}
+	return converted
+}
+
+//lossless 判断数值转换为整数时是否保留了原值（转换为浮点数时允许精度舍入）
+func lossless(value, converted reflect.Value) bool {
+	if kind := converted.Kind(); kind == reflect.Float32 || kind == reflect.Float64 {
+		return true
+	}
+	var sign = func(v reflect.Value) int {
+		switch v.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			if n := v.Int(); n < 0 {
+				return -1
+			} else if n > 0 {
+				return 1
+			}
+		case reflect.Float32, reflect.Float64:
+			if f := v.Float(); f < 0 {
+				return -1
+			} else if f > 0 {
+				return 1
+			}
+		default:
+			if v.Uint() > 0 {
+				return 1
+			}
+		}
+		return 0
+	}
+	return sign(value) == sign(converted) && converted.Convert(value.Type()).Interface() == value.Interface()
+}
+
+//clone 拷贝值，避免持有切片元素的可寻址引用
+func clone(v reflect.Value) reflect.Value {
+	var value = reflect.New(v.Type()).Elem()
+	value.Set(v)
+	return value
+}
+
+//stopped 判断遍历函数的返回值是否要求终止遍历
+//第一个返回值为 false 或最后一个返回值为非空 error 时终止。
+func stopped(back []reflect.Value) bool {
+	if len(back) == 0 {
+		return false
+	}
+	var first = back[0]
+	return first.Kind() == reflect.Bool && !first.Bool() || failed(back) != nil
+}
+
+//failed 获取遍历函数最后一个返回值中的非空 error
+func failed(back []reflect.Value) error {
+	if len(back) == 0 {
+		return nil
+	}
+	err, _ := back[len(back)-1].Interface().(error)
+	return err
+}
+
+//recovered 将 panic 恢复为返回值，供 Try* 操作使用
+//本包抛出的错误原样返回，其他 panic 包装为 LambdaPanicked。
+func recovered(operator string, err *error) {
+	if r := recover(); r != nil {
+		if !isCollectionError(r) {
+			r = throwLambdaPanicked(nil, r)
+		}
+		if lp, ok := r.(*LambdaPanicked); ok && lp.Operator == "" {
+			lp.Operator = operator
+		}
+		*err = r.(error)
+	}
+}
@@ -0,0 +1,89 @@
+package collections_test
+
+import (
+	"testing"
+
+	"github.com/johnwiichang/collections"
+)
+
+var benchNumbers = func() []int {
+	var numbers = make([]int, 1000)
+	for i := range numbers {
+		numbers[i] = i
+	}
+	return numbers
+}()
+
+func BenchmarkSelectLoop(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		var result = make([]int, len(benchNumbers))
+		for i, x := range benchNumbers {
+			result[i] = x * 2
+		}
+	}
+}
+
+func BenchmarkSelect(b *testing.B) {
+	var list = collections.From(benchNumbers).List()
+	for n := 0; n < b.N; n++ {
+		list.Select(func(x int) int { return x * 2 })
+	}
+}
+
+func BenchmarkWhereLoop(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		var result []int
+		for _, x := range benchNumbers {
+			if x%2 == 0 {
+				result = append(result, x)
+			}
+		}
+	}
+}
+
+func BenchmarkWhere(b *testing.B) {
+	var list = collections.From(benchNumbers).List()
+	for n := 0; n < b.N; n++ {
+		list.Where(func(x int) bool { return x%2 == 0 })
+	}
+}
+
+func BenchmarkForEach(b *testing.B) {
+	var list = collections.From(benchNumbers).List()
+	for n := 0; n < b.N; n++ {
+		var sum int
+		list.ForEach(func(x int) { sum += x })
+	}
+}
+
+func BenchmarkSignature(b *testing.B) {
+	var list = collections.From([]int{1}).List()
+	for n := 0; n < b.N; n++ {
+		list.Where(func(x int) bool { return true })
+	}
+}
+
+//benchInts 命名切片类型不走快速路径，用于衡量反射路径
+type benchInts []int
+
+func BenchmarkSelectReflective(b *testing.B) {
+	var list = collections.From(benchInts(benchNumbers)).List()
+	for n := 0; n < b.N; n++ {
+		list.Select(func(x int) int { return x * 2 })
+	}
+}
+
+func BenchmarkWhereReflective(b *testing.B) {
+	var list = collections.From(benchInts(benchNumbers)).List()
+	for n := 0; n < b.N; n++ {
+		list.Where(func(x int) bool { return x%2 == 0 })
+	}
+}
+
+func BenchmarkForEachReflective(b *testing.B) {
+	var list = collections.From(benchInts(benchNumbers)).List()
+	for n := 0; n < b.N; n++ {
+		var sum int
+		list.ForEach(func(x int) { sum += x })
+	}
+}
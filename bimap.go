@@ -0,0 +1,102 @@
+package collections
+
+import (
+	"reflect"
+)
+
+type (
+	BiMap interface {
+		Dictionary
+
+		GetByKey(key interface{}) (interface{}, bool)
+		GetByValue(value interface{}) (interface{}, bool)
+		Set(key, value interface{}) BiMap
+		TrySet(key, value interface{}) bool
+		RemoveByKey(key interface{}) bool
+		RemoveByValue(value interface{}) bool
+		Inverse() BiMap
+	}
+
+	//bimap 双向映射集合，值保持唯一，读操作由正向映射提供
+	bimap struct {
+		*dictionary
+		backward *dictionary
+	}
+)
+
+func newBiMap(d Dictionary) *bimap {
+	var forward = newDictionary(d.Type(), d.Count())
+	d.ForEach(func(k, v interface{}) {
+		forward.value.SetMapIndex(convertTo(k, d.Type().Key()), convertTo(v, d.Type().Elem()))
+	})
+	return &bimap{dictionary: forward, backward: invert(d)}
+}
+
+func (bm *bimap) GetByKey(key interface{}) (interface{}, bool) {
+	if value := bm.value.MapIndex(convertTo(key, bm.t.Key())); value.IsValid() {
+		return value.Interface(), true
+	}
+	return nil, false
+}
+
+func (bm *bimap) GetByValue(value interface{}) (interface{}, bool) {
+	if key := bm.backward.value.MapIndex(convertTo(value, bm.t.Elem())); key.IsValid() {
+		return key.Interface(), true
+	}
+	return nil, false
+}
+
+//conflict 检查值是否已被其他键占用
+func (bm *bimap) conflict(key, value reflect.Value) bool {
+	var owner = bm.backward.value.MapIndex(value)
+	return owner.IsValid() && owner.Interface() != key.Interface()
+}
+
+func (bm *bimap) set(key, value reflect.Value) {
+	if old := bm.value.MapIndex(key); old.IsValid() {
+		bm.backward.value.SetMapIndex(old, reflect.Value{})
+	}
+	bm.value.SetMapIndex(key, value)
+	bm.backward.value.SetMapIndex(value, key)
+}
+
+//Set 设置键与值
+//如果值已被其他键占用，那么会抛出 panic 异常。
+func (bm *bimap) Set(key, value interface{}) BiMap {
+	var k, v = convertTo(key, bm.t.Key()), convertTo(value, bm.t.Elem())
+	if bm.conflict(k, v) {
+		panic(throwValueIsDuplicated(bm.t, value))
+	}
+	bm.set(k, v)
+	return bm
+}
+
+//TrySet 设置键与值，值已被其他键占用时返回 false
+func (bm *bimap) TrySet(key, value interface{}) bool {
+	var k, v = convertTo(key, bm.t.Key()), convertTo(value, bm.t.Elem())
+	if bm.conflict(k, v) {
+		return false
+	}
+	bm.set(k, v)
+	return true
+}
+
+func (bm *bimap) RemoveByKey(key interface{}) bool {
+	var k = convertTo(key, bm.t.Key())
+	var value = bm.value.MapIndex(k)
+	if !value.IsValid() {
+		return false
+	}
+	bm.value.SetMapIndex(k, reflect.Value{})
+	bm.backward.value.SetMapIndex(value, reflect.Value{})
+	return true
+}
+
+func (bm *bimap) RemoveByValue(value interface{}) bool {
+	return bm.Inverse().RemoveByKey(value)
+}
+
+//Inverse 获取反向视图，与当前集合共享存储
+func (bm *bimap) Inverse() BiMap {
+	return &bimap{dictionary: bm.backward, backward: bm.dictionary}
+}
@@ -0,0 +1,206 @@
+package collections
+
+import (
+	"encoding/hex"
+	"math/bits"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+type (
+	BitSet interface {
+		Set(bits ...int) BitSet
+		Clear(bits ...int) BitSet
+		Test(bit int) bool
+		Count() int
+		And(b BitSet) BitSet
+		Or(b BitSet) BitSet
+		Xor(b BitSet) BitSet
+		AndNot(b BitSet) BitSet
+		NextSet(from int) (int, bool)
+		List() List
+
+		MarshalBinary() ([]byte, error)
+		UnmarshalBinary(data []byte) error
+		MarshalText() ([]byte, error)
+		UnmarshalText(text []byte) error
+		String() string
+	}
+
+	//bitset 稠密非负整数集合，第 i 位表示 i 是否存在
+	bitset struct {
+		words []uint64
+	}
+)
+
+//NewBitSet 创建包含给出元素的位集合
+//元素为负数时抛出 ArgumentIsInvalid 异常。
+func NewBitSet(bits ...int) BitSet {
+	return new(bitset).Set(bits...)
+}
+
+//check 元素必须为非负整数
+func (bs *bitset) check(bit int) {
+	if bit < 0 {
+		panic(throwArgumentIsInvalid("bit", bit))
+	}
+}
+
+//trim 移除末尾的空字
+func (bs *bitset) trim() *bitset {
+	var length = len(bs.words)
+	for length > 0 && bs.words[length-1] == 0 {
+		length--
+	}
+	bs.words = bs.words[:length]
+	return bs
+}
+
+func (bs *bitset) Set(bits ...int) BitSet {
+	for _, bit := range bits {
+		bs.check(bit)
+		for bit/64 >= len(bs.words) {
+			bs.words = append(bs.words, 0)
+		}
+		bs.words[bit/64] |= 1 << uint(bit%64)
+	}
+	return bs
+}
+
+func (bs *bitset) Clear(bits ...int) BitSet {
+	for _, bit := range bits {
+		bs.check(bit)
+		if bit/64 < len(bs.words) {
+			bs.words[bit/64] &^= 1 << uint(bit%64)
+		}
+	}
+	return bs.trim()
+}
+
+func (bs *bitset) Test(bit int) bool {
+	return bit >= 0 && bit/64 < len(bs.words) && bs.words[bit/64]&(1<<uint(bit%64)) != 0
+}
+
+//Count 获取集合中元素的数量
+func (bs *bitset) Count() (count int) {
+	for _, word := range bs.words {
+		count += bits.OnesCount64(word)
+	}
+	return
+}
+
+//combine 逐字合并两个集合（较短的集合以 0 补齐）
+func (bs *bitset) combine(other BitSet, f func(a, b uint64) uint64) BitSet {
+	var o = other.(*bitset)
+	var length = len(bs.words)
+	if len(o.words) > length {
+		length = len(o.words)
+	}
+	var newset = &bitset{words: make([]uint64, length)}
+	for i := range newset.words {
+		var a, b uint64
+		if i < len(bs.words) {
+			a = bs.words[i]
+		}
+		if i < len(o.words) {
+			b = o.words[i]
+		}
+		newset.words[i] = f(a, b)
+	}
+	return newset.trim()
+}
+
+func (bs *bitset) And(other BitSet) BitSet {
+	return bs.combine(other, func(a, b uint64) uint64 { return a & b })
+}
+
+func (bs *bitset) Or(other BitSet) BitSet {
+	return bs.combine(other, func(a, b uint64) uint64 { return a | b })
+}
+
+func (bs *bitset) Xor(other BitSet) BitSet {
+	return bs.combine(other, func(a, b uint64) uint64 { return a ^ b })
+}
+
+func (bs *bitset) AndNot(other BitSet) BitSet {
+	return bs.combine(other, func(a, b uint64) uint64 { return a &^ b })
+}
+
+//NextSet 获取大于等于 from 的第一个元素
+func (bs *bitset) NextSet(from int) (int, bool) {
+	if from < 0 {
+		from = 0
+	}
+	for index := from / 64; index < len(bs.words); index++ {
+		var word = bs.words[index]
+		if index == from/64 {
+			word &= ^uint64(0) << uint(from%64)
+		}
+		if word != 0 {
+			return index*64 + bits.TrailingZeros64(word), true
+		}
+	}
+	return 0, false
+}
+
+//List 按从小到大的顺序获取 []int 的 List 集合
+func (bs *bitset) List() List {
+	var newlist = newList(reflect.TypeOf([]int{}))
+	var values = make([]int, 0, bs.Count())
+	for bit, ok := bs.NextSet(0); ok; bit, ok = bs.NextSet(bit + 1) {
+		values = append(values, bit)
+	}
+	newlist.value.Set(reflect.ValueOf(values))
+	return newlist
+}
+
+//MarshalBinary 按小端序输出字节（第 i 位位于第 i/8 个字节），不包含末尾的空字节
+func (bs *bitset) MarshalBinary() ([]byte, error) {
+	var data = make([]byte, len(bs.words)*8)
+	for i := range data {
+		data[i] = byte(bs.words[i/8] >> uint(i%8*8))
+	}
+	var length = len(data)
+	for length > 0 && data[length-1] == 0 {
+		length--
+	}
+	return data[:length], nil
+}
+
+func (bs *bitset) UnmarshalBinary(data []byte) error {
+	bs.words = make([]uint64, (len(data)+7)/8)
+	for i, b := range data {
+		bs.words[i/8] |= uint64(b) << uint(i%8*8)
+	}
+	bs.trim()
+	return nil
+}
+
+//MarshalText 输出二进制形式的十六进制文本
+func (bs *bitset) MarshalText() ([]byte, error) {
+	var data, _ = bs.MarshalBinary()
+	return []byte(hex.EncodeToString(data)), nil
+}
+
+func (bs *bitset) UnmarshalText(text []byte) error {
+	var data, err = hex.DecodeString(string(text))
+	if err != nil {
+		return err
+	}
+	return bs.UnmarshalBinary(data)
+}
+
+//String 以 {1 3 5} 的形式输出集合
+func (bs *bitset) String() string {
+	var builder strings.Builder
+	builder.WriteByte('{')
+	for bit, ok := bs.NextSet(0); ok; bit, ok = bs.NextSet(bit + 1) {
+		if builder.Len() > 1 {
+			builder.WriteByte(' ')
+		}
+		builder.WriteString(strconv.Itoa(bit))
+	}
+	builder.WriteByte('}')
+	return builder.String()
+}
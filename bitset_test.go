@@ -0,0 +1,73 @@
+package collections_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/johnwiichang/collections"
+)
+
+func TestBitSet(t *testing.T) {
+	var a = collections.From([]int{1, 3, 5, 64, 130}).List().ToBitSet()
+	var b = collections.From([]uint8{3, 4, 5}).List().ToBitSet()
+	if !a.Test(64) || a.Test(2) || a.Test(-1) || a.Count() != 5 {
+		t.Fail()
+	}
+	var sets = map[string]collections.BitSet{
+		"{3 5}":                a.And(b),
+		"{1 3 4 5 64 130}":     a.Or(b),
+		"{1 4 64 130}":         a.Xor(b),
+		"{1 64 130}":           a.AndNot(b),
+		"{1 3 5 64}":           a.Or(b).Clear(130, 4, 1000),
+		"{}":                   b.AndNot(a.Or(b)),
+		"{0 1 3 5 64 130 200}": a.Or(b).AndNot(b).Set(0, 3, 5, 200),
+	}
+	for expected, set := range sets {
+		if set.String() != expected {
+			t.Errorf("expected %s but got %s", expected, set)
+		}
+	}
+	if next, _ := a.NextSet(6); next != 64 {
+		t.Fail()
+	}
+	if _, ok := a.NextSet(131); ok {
+		t.Fail()
+	}
+	if !reflect.DeepEqual(a.List().Slice(), []int{1, 3, 5, 64, 130}) {
+		t.Fail()
+	}
+	for _, negative := range []func(){
+		func() { a.Set(-1) },
+		func() { a.Clear(-1) },
+		func() { collections.NewBitSet(2, -3) },
+		func() { collections.From([]int{1, -1}).List().ToBitSet() },
+	} {
+		var invalid *collections.ArgumentIsInvalid
+		if err := recoverError(negative); !errors.As(err, &invalid) || invalid.Argument != "bit" {
+			t.Fail()
+		}
+	}
+	if collections.NewBitSet().Count() != 0 || collections.NewBitSet(130, 1, 1).String() != "{1 130}" || !collections.NewBitSet(7).Test(7) {
+		t.Fail()
+	}
+	EstimateFail(t, func(*testing.T) {
+		collections.From([]string{}).List().ToBitSet()
+	})
+}
+
+func TestBitSetSerialization(t *testing.T) {
+	var a = collections.From([]int{0, 9, 70}).List().ToBitSet()
+	var data, _ = a.MarshalBinary()
+	var text, _ = a.MarshalText()
+	if len(data) != 9 || string(text) != "010200000000000040" {
+		t.Fail()
+	}
+	var b, c = collections.From([]int{}).List().ToBitSet(), collections.From([]int{}).List().ToBitSet()
+	if b.UnmarshalBinary(data) != nil || c.UnmarshalText(text) != nil || b.String() != a.String() || c.String() != a.String() {
+		t.Fail()
+	}
+	if c.UnmarshalText([]byte("xyz")) == nil {
+		t.Fail()
+	}
+}
@@ -0,0 +1,276 @@
+package collections
+
+import (
+	clist "container/list"
+	"reflect"
+	"sync"
+	"time"
+)
+
+const (
+	//LRU 淘汰最久未使用的元素
+	LRU CachePolicy = iota
+	//LFU 淘汰使用次数最少的元素（次数相同时淘汰最久未使用的元素）
+	LFU
+)
+
+type (
+	CachePolicy int
+
+	//CacheOptions 缓存选项
+	//OnEvict 为 func(K, V) 形式的淘汰回调（容量淘汰与过期淘汰时调用，Remove 不会调用），Clock 用于替换 time.Now。
+	CacheOptions struct {
+		Policy  CachePolicy
+		TTL     time.Duration
+		OnEvict interface{}
+		Clock   func() time.Time
+	}
+
+	Cache interface {
+		Get(key interface{}) (interface{}, bool)
+		Peek(key interface{}) (interface{}, bool)
+		Set(key, value interface{}) Cache
+		Remove(key interface{}) bool
+		Len() int
+		Dictionary() Dictionary
+		Strict(strict ...bool) Cache
+
+		Type() reflect.Type
+	}
+
+	cacheEntry struct {
+		key, value reflect.Value
+		frequency  int
+		expire     time.Time
+		element    *clist.Element
+	}
+
+	//cache 有界缓存，按使用频次分桶（LRU 策略下只有一个桶），每个桶内按最近使用排序
+	cache struct {
+		t        reflect.Type
+		capacity int
+		options  CacheOptions
+		onEvict  lambdaFunc
+
+		mutex     sync.Mutex
+		entries   map[interface{}]*cacheEntry
+		buckets   map[int]*clist.List
+		frequency int
+		strictness
+	}
+)
+
+func newCache(t reflect.Type, capacity int, options ...CacheOptions) *cache {
+	if capacity < 0 {
+		panic(throwArgumentIsInvalid("capacity", capacity))
+	}
+	var c = &cache{
+		t: t, capacity: capacity,
+		entries: make(map[interface{}]*cacheEntry),
+		buckets: make(map[int]*clist.List),
+	}
+	if len(options) > 0 {
+		c.options = options[0]
+	}
+	if c.options.Clock == nil {
+		c.options.Clock = time.Now
+	}
+	if c.options.OnEvict != nil {
+		c.onEvict = lambda("Cache.OnEvict", c.options.OnEvict, newFunc(t.Key(), t.Elem())()())
+	}
+	return c
+}
+
+func (c *cache) Type() reflect.Type {
+	return c.t
+}
+
+func (c *cache) bucket(frequency int) *clist.List {
+	var bucket, existed = c.buckets[frequency]
+	if !existed {
+		bucket = clist.New()
+		c.buckets[frequency] = bucket
+	}
+	return bucket
+}
+
+func (c *cache) detach(entry *cacheEntry) {
+	var bucket = c.buckets[entry.frequency]
+	bucket.Remove(entry.element)
+	if bucket.Len() == 0 {
+		delete(c.buckets, entry.frequency)
+	}
+}
+
+//touch 记录一次使用
+func (c *cache) touch(entry *cacheEntry) {
+	if c.options.Policy != LFU {
+		c.buckets[entry.frequency].MoveToFront(entry.element)
+		return
+	}
+	c.detach(entry)
+	if _, existed := c.buckets[c.frequency]; !existed && c.frequency == entry.frequency {
+		c.frequency++
+	}
+	entry.frequency++
+	entry.element = c.bucket(entry.frequency).PushFront(entry)
+}
+
+//victim 获取待淘汰的元素（最小频次桶中最久未使用的元素）
+//Remove 或过期淘汰可能使最小频次桶为空，此时重新计算最小频次。
+func (c *cache) victim() *cacheEntry {
+	if _, existed := c.buckets[c.frequency]; !existed {
+		var first = true
+		for frequency := range c.buckets {
+			if first || frequency < c.frequency {
+				c.frequency, first = frequency, false
+			}
+		}
+	}
+	return c.buckets[c.frequency].Back().Value.(*cacheEntry)
+}
+
+func (c *cache) remove(entry *cacheEntry) {
+	c.detach(entry)
+	delete(c.entries, entry.key.Interface())
+}
+
+func (c *cache) expired(entry *cacheEntry) bool {
+	return c.options.TTL > 0 && !c.options.Clock().Before(entry.expire)
+}
+
+//lookup 查找未过期的元素，过期元素会被移除并加入淘汰列表
+func (c *cache) lookup(key reflect.Value, evicted *[]*cacheEntry) *cacheEntry {
+	var entry, existed = c.entries[key.Interface()]
+	if !existed {
+		return nil
+	}
+	if c.expired(entry) {
+		c.remove(entry)
+		*evicted = append(*evicted, entry)
+		return nil
+	}
+	return entry
+}
+
+//purge 移除全部过期元素
+func (c *cache) purge(evicted *[]*cacheEntry) {
+	if c.options.TTL <= 0 {
+		return
+	}
+	for _, entry := range c.entries {
+		if c.expired(entry) {
+			c.remove(entry)
+			*evicted = append(*evicted, entry)
+		}
+	}
+}
+
+//notify 在释放锁之后调用淘汰回调，避免回调中访问缓存时死锁
+func (c *cache) notify(evicted []*cacheEntry) {
+	if !c.onEvict.IsValid() {
+		return
+	}
+	for _, entry := range evicted {
+		c.onEvict.call(entry.key, entry.value)
+	}
+}
+
+//Strict 为当前缓存设置严格类型匹配模式（不给出参数时开启），影响写入与查找时传入的键和值
+func (c *cache) Strict(strict ...bool) Cache {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.setStrict(strict)
+	return c
+}
+
+func (c *cache) Get(key interface{}) (interface{}, bool) {
+	var evicted []*cacheEntry
+	defer func() { c.notify(evicted) }()
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if entry := c.lookup(c.convertTo(key, c.t.Key()), &evicted); entry != nil {
+		c.touch(entry)
+		return entry.value.Interface(), true
+	}
+	return nil, false
+}
+
+//Peek 获取元素但不记录使用
+func (c *cache) Peek(key interface{}) (interface{}, bool) {
+	var evicted []*cacheEntry
+	defer func() { c.notify(evicted) }()
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if entry := c.lookup(c.convertTo(key, c.t.Key()), &evicted); entry != nil {
+		return entry.value.Interface(), true
+	}
+	return nil, false
+}
+
+//Set 写入元素，超出容量时按策略淘汰
+func (c *cache) Set(key, value interface{}) Cache {
+	var evicted []*cacheEntry
+	defer func() { c.notify(evicted) }()
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	var k, v = c.convertTo(key, c.t.Key()), c.convertTo(value, c.t.Elem())
+	var expire = c.options.Clock().Add(c.options.TTL)
+	if entry := c.lookup(k, &evicted); entry != nil {
+		entry.value, entry.expire = v, expire
+		c.touch(entry)
+		return c
+	}
+	if c.capacity > 0 && len(c.entries) >= c.capacity {
+		c.purge(&evicted)
+	}
+	if c.capacity > 0 && len(c.entries) >= c.capacity {
+		var victim = c.victim()
+		c.remove(victim)
+		evicted = append(evicted, victim)
+	}
+	var entry = &cacheEntry{key: k, value: v, expire: expire}
+	if c.options.Policy == LFU {
+		entry.frequency, c.frequency = 1, 1
+	}
+	entry.element = c.bucket(entry.frequency).PushFront(entry)
+	c.entries[k.Interface()] = entry
+	return c
+}
+
+//Remove 移除未过期的元素，元素已过期时按过期淘汰处理并返回 false
+func (c *cache) Remove(key interface{}) bool {
+	var evicted []*cacheEntry
+	defer func() { c.notify(evicted) }()
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if entry := c.lookup(c.convertTo(key, c.t.Key()), &evicted); entry != nil {
+		c.remove(entry)
+		return true
+	}
+	return false
+}
+
+//Len 获取未过期元素的数量
+func (c *cache) Len() int {
+	var evicted []*cacheEntry
+	defer func() { c.notify(evicted) }()
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.purge(&evicted)
+	return len(c.entries)
+}
+
+//Dictionary 获取未过期元素的快照
+func (c *cache) Dictionary() Dictionary {
+	var evicted []*cacheEntry
+	defer func() { c.notify(evicted) }()
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.purge(&evicted)
+	var dict = newDictionary(c.t, len(c.entries))
+	for _, entry := range c.entries {
+		dict.value.SetMapIndex(entry.key, entry.value)
+	}
+	return dict
+}
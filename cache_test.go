@@ -0,0 +1,104 @@
+package collections_test
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/johnwiichang/collections"
+)
+
+func TestCacheLRU(t *testing.T) {
+	var evicted []int
+	var cache = collections.From(map[int]string{}).Cache(2, collections.CacheOptions{
+		OnEvict: func(k int, v string) { evicted = append(evicted, k) },
+	})
+	cache.Set(1, "1").Set(2, "2")
+	cache.Get(1)
+	cache.Set(3, "3")
+	if _, ok := cache.Peek(2); ok || !reflect.DeepEqual(evicted, []int{2}) {
+		t.Fail()
+	}
+	cache.Peek(1)
+	cache.Set(4, "4")
+	if !reflect.DeepEqual(cache.Dictionary().Map(), map[int]string{3: "3", 4: "4"}) {
+		t.Fail()
+	}
+	if !cache.Remove(3) || cache.Remove(3) || cache.Len() != 1 {
+		t.Fail()
+	}
+	EstimateFail(t, func(*testing.T) {
+		collections.From(map[int]string{}).Cache(1, collections.CacheOptions{OnEvict: func(string) {}})
+	})
+	var err = recoverError(func() { collections.From(map[int]string{}).Cache(-1) })
+	var invalid *collections.ArgumentIsInvalid
+	if !errors.As(err, &invalid) || invalid.Argument != "capacity" || invalid.Value != -1 {
+		t.Fail()
+	}
+	if collections.From(map[int]string{1: "a", 2: "b"}).Cache(0).Len() != 2 {
+		t.Fail()
+	}
+}
+
+func TestCacheLFU(t *testing.T) {
+	var cache = collections.From(map[string]int{"a": 1, "b": 2}).Cache(2, collections.CacheOptions{Policy: collections.LFU})
+	cache.Get("a")
+	cache.Get("a")
+	cache.Get("b")
+	cache.Set("c", 3)
+	if _, ok := cache.Get("b"); ok {
+		t.Fail()
+	}
+	cache.Remove("c")
+	cache.Set("d", 4).Set("e", 5)
+	if _, ok := cache.Get("a"); !ok || cache.Len() != 2 {
+		t.Fail()
+	}
+}
+
+func TestCacheTTL(t *testing.T) {
+	var now = time.Unix(0, 0)
+	var evicted int
+	var cache = collections.From(map[int]int{}).Cache(0, collections.CacheOptions{
+		TTL:     time.Minute,
+		Clock:   func() time.Time { return now },
+		OnEvict: func(int, int) { evicted++ },
+	})
+	cache.Set(1, 1)
+	now = now.Add(30 * time.Second)
+	cache.Set(2, 2)
+	now = now.Add(45 * time.Second)
+	if _, ok := cache.Get(1); ok || cache.Len() != 1 || evicted != 1 {
+		t.Fail()
+	}
+	now = now.Add(time.Minute)
+	if cache.Dictionary().Count() != 0 || evicted != 2 {
+		t.Fail()
+	}
+	cache.Set(3, 3)
+	now = now.Add(time.Minute)
+	if cache.Remove(3) || evicted != 3 {
+		t.Fail()
+	}
+}
+
+func TestCacheConcurrency(t *testing.T) {
+	var cache = collections.From(map[int]int{}).Cache(16)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				cache.Set(i*100+j, j)
+				cache.Get(j)
+			}
+		}(i)
+	}
+	wg.Wait()
+	if cache.Len() != 16 {
+		t.Fail()
+	}
+}
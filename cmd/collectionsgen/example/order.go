@@ -0,0 +1,11 @@
+//Package example 演示 collectionsgen 生成的强类型集合
+package example
+
+//go:generate go run github.com/johnwiichang/collections/cmd/collectionsgen -type=Order -key=string
+
+//Order 订单
+type Order struct {
+	ID       string
+	Customer string
+	Total    float64
+}
@@ -0,0 +1,74 @@
+package example_test
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/johnwiichang/collections"
+	"github.com/johnwiichang/collections/cmd/collectionsgen/example"
+)
+
+var orders = example.OrderList{
+	{ID: "1", Customer: "alice", Total: 30},
+	{ID: "2", Customer: "bob", Total: 12.5},
+	{ID: "3", Customer: "alice", Total: 8},
+}
+
+func TestOrderList(t *testing.T) {
+	var large = orders.Where(func(o example.Order) bool { return o.Total > 10 })
+	if large.Count() != 2 || orders.Count(func(o example.Order) bool { return o.Customer == "alice" }) != 2 {
+		t.Fail()
+	}
+	if orders.First(func(o example.Order) bool { return o.Customer == "alice" }) != 0 || orders.Last(func(o example.Order) bool { return o.Customer == "alice" }) != 2 {
+		t.Fail()
+	}
+	var doubled = orders.Select(func(o example.Order) example.Order { o.Total *= 2; return o })
+	if doubled[1].Total != 25 || orders[1].Total != 12.5 {
+		t.Fail()
+	}
+	var groups = orders.GroupBy(func(o example.Order) string { return o.Customer })
+	if len(groups["alice"]) != 2 || groups["alice"][1].ID != "3" {
+		t.Fail()
+	}
+	var sorted = orders.Concat(nil).Sort(func(a, b example.Order) bool { return a.Total < b.Total })
+	if sorted[0].ID != "3" || orders[0].ID != "1" || sorted.Reverse()[0].ID != "1" {
+		t.Fail()
+	}
+	if ids := orders.Skip(1).Take(5); len(ids) != 2 || ids[0].ID != "2" || len(orders.Skip(9)) != 0 {
+		t.Fail()
+	}
+	var visited int
+	orders.ForEach(func(i int, o example.Order) bool { visited++; return i < 1 })
+	if visited != 2 {
+		t.Fail()
+	}
+	//生成的类型仍可转换为动态 List
+	var dynamic = collections.From(orders).List().Where(func(o example.Order) bool { return o.Total > 10 })
+	if !reflect.DeepEqual(dynamic.Slice(), large) || !reflect.DeepEqual(orders.List().Slice(), orders.Slice()) {
+		t.Fail()
+	}
+}
+
+func TestOrderDictionary(t *testing.T) {
+	var byID = orders.ToDictionary(func(o example.Order) string { return o.ID })
+	var keys = byID.Keys()
+	sort.Strings(keys)
+	if !reflect.DeepEqual(keys, []string{"1", "2", "3"}) || byID.Values().Count() != 3 {
+		t.Fail()
+	}
+	var alice = byID.Where(func(id string, o example.Order) bool { return o.Customer == "alice" })
+	if alice.Count() != 2 || byID.Count(func(id string, o example.Order) bool { return o.Total < 10 }) != 1 {
+		t.Fail()
+	}
+	var merged = alice.Merge(example.OrderDictionary{"1": {ID: "1", Total: 5}}, func(old, new example.Order) example.Order {
+		old.Total += new.Total
+		return old
+	})
+	if merged["1"].Total != 35 || merged.Select(func(id string, o example.Order) example.Order { o.Total = 0; return o })["3"].Total != 0 {
+		t.Fail()
+	}
+	if byID.Dictionary().Count() != 3 || collections.From(byID).Dictionary().Keys().Count() != 3 {
+		t.Fail()
+	}
+}
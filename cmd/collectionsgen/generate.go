@@ -0,0 +1,277 @@
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"strings"
+	"text/template"
+)
+
+type (
+	//options 生成选项
+	options struct {
+		Package string
+		Types   []string
+		Key     string
+		Args    string
+	}
+
+	//wrapper 单个类型的模板数据
+	wrapper struct {
+		Type, Key        string
+		List, Dictionary string
+		Sorter           string
+	}
+)
+
+//generate 生成强类型集合包装的源码（已格式化）
+func generate(opts options) ([]byte, error) {
+	var wrappers = make([]wrapper, len(opts.Types))
+	for i, t := range opts.Types {
+		wrappers[i] = wrapper{
+			Type: t, Key: opts.Key,
+			List: t + "List", Dictionary: t + "Dictionary",
+			Sorter: strings.ToLower(t[:1]) + t[1:] + "ListSorter",
+		}
+	}
+	var buffer bytes.Buffer
+	if err := source.Execute(&buffer, struct {
+		options
+		Wrappers []wrapper
+	}{opts, wrappers}); err != nil {
+		return nil, err
+	}
+	return format.Source(buffer.Bytes())
+}
+
+var source = template.Must(template.New("source").Parse(`// Code generated by "collectionsgen {{.Args}}"; DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"sort"
+
+	"github.com/johnwiichang/collections"
+)
+{{range .Wrappers}}
+type (
+	//{{.List}} 强类型的 {{.Type}} 列表，方法与 collections.List 同名但不使用反射
+	{{.List}} []{{.Type}}
+
+	//{{.Dictionary}} 强类型的 {{.Type}} 字典，方法与 collections.Dictionary 同名但不使用反射
+	{{.Dictionary}} map[{{.Key}}]{{.Type}}
+
+	{{.Sorter}} struct {
+		items []{{.Type}}
+		less  func(a, b {{.Type}}) bool
+	}
+)
+
+func (s {{.Sorter}}) Len() int           { return len(s.items) }
+func (s {{.Sorter}}) Less(i, j int) bool { return s.less(s.items[i], s.items[j]) }
+func (s {{.Sorter}}) Swap(i, j int)      { s.items[i], s.items[j] = s.items[j], s.items[i] }
+
+//Slice 获取底层切片
+func (l {{.List}}) Slice() []{{.Type}} {
+	return []{{.Type}}(l)
+}
+
+//List 转换为动态的 collections.List
+func (l {{.List}}) List() collections.List {
+	return collections.From([]{{.Type}}(l)).List()
+}
+
+//Select 映射为新的列表
+//仅支持映射为 {{.Type}}，映射为其他类型时请使用 List().Select。
+func (l {{.List}}) Select(f func({{.Type}}) {{.Type}}) {{.List}} {
+	var result = make({{.List}}, len(l))
+	for i, item := range l {
+		result[i] = f(item)
+	}
+	return result
+}
+
+//Where 筛选满足条件的元素
+func (l {{.List}}) Where(f func({{.Type}}) bool) {{.List}} {
+	var result {{.List}}
+	for _, item := range l {
+		if f(item) {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+//ForEach 遍历元素，函数返回 false 时终止遍历
+func (l {{.List}}) ForEach(f func(int, {{.Type}}) bool) {{.List}} {
+	for i, item := range l {
+		if !f(i, item) {
+			break
+		}
+	}
+	return l
+}
+
+//Count 计算（满足条件的）元素数量
+func (l {{.List}}) Count(f ...func({{.Type}}) bool) int {
+	if len(f) == 0 {
+		return len(l)
+	}
+	return len(l.Where(f[0]))
+}
+
+//First 获取首个满足条件的元素位置，不存在时返回 -1
+func (l {{.List}}) First(f func({{.Type}}) bool) int {
+	for i, item := range l {
+		if f(item) {
+			return i
+		}
+	}
+	return -1
+}
+
+//Last 获取最后一个满足条件的元素位置，不存在时返回 -1
+func (l {{.List}}) Last(f func({{.Type}}) bool) int {
+	for i := len(l) - 1; i >= 0; i-- {
+		if f(l[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+//Sort 就地稳定排序
+func (l {{.List}}) Sort(less func(a, b {{.Type}}) bool) {{.List}} {
+	sort.Stable({{.Sorter}}{items: l, less: less})
+	return l
+}
+
+//Reverse 就地反转
+func (l {{.List}}) Reverse() {{.List}} {
+	for i, j := 0, len(l)-1; i < j; i, j = i+1, j-1 {
+		l[i], l[j] = l[j], l[i]
+	}
+	return l
+}
+
+//Skip 跳过一定数量的元素
+func (l {{.List}}) Skip(length int) {{.List}} {
+	if length > len(l) {
+		length = len(l)
+	}
+	return l[length:]
+}
+
+//Take 选择一定数量的元素（元素不够时长度会不足）
+func (l {{.List}}) Take(num int) {{.List}} {
+	if num > len(l) {
+		num = len(l)
+	}
+	return l[:num]
+}
+
+//Concat 连接列表并创建新列表
+func (l {{.List}}) Concat(other {{.List}}) {{.List}} {
+	return append(append(make({{.List}}, 0, len(l)+len(other)), l...), other...)
+}
+
+//GroupBy 按键分组，组内保持原有顺序
+func (l {{.List}}) GroupBy(key func({{.Type}}) {{.Key}}) map[{{.Key}}]{{.List}} {
+	var groups = make(map[{{.Key}}]{{.List}})
+	for _, item := range l {
+		var k = key(item)
+		groups[k] = append(groups[k], item)
+	}
+	return groups
+}
+
+//ToDictionary 按键转换为字典，键重复时后出现的元素覆盖先出现的元素
+func (l {{.List}}) ToDictionary(key func({{.Type}}) {{.Key}}) {{.Dictionary}} {
+	var d = make({{.Dictionary}}, len(l))
+	for _, item := range l {
+		d[key(item)] = item
+	}
+	return d
+}
+
+//Map 获取底层映射
+func (d {{.Dictionary}}) Map() map[{{.Key}}]{{.Type}} {
+	return map[{{.Key}}]{{.Type}}(d)
+}
+
+//Dictionary 转换为动态的 collections.Dictionary
+func (d {{.Dictionary}}) Dictionary() collections.Dictionary {
+	return collections.From(map[{{.Key}}]{{.Type}}(d)).Dictionary()
+}
+
+//Keys 获取全部键
+func (d {{.Dictionary}}) Keys() []{{.Key}} {
+	var keys = make([]{{.Key}}, 0, len(d))
+	for k := range d {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+//Values 获取全部值
+func (d {{.Dictionary}}) Values() {{.List}} {
+	var values = make({{.List}}, 0, len(d))
+	for _, v := range d {
+		values = append(values, v)
+	}
+	return values
+}
+
+//Where 筛选满足条件的键值对
+func (d {{.Dictionary}}) Where(f func({{.Key}}, {{.Type}}) bool) {{.Dictionary}} {
+	var result = make({{.Dictionary}})
+	for k, v := range d {
+		if f(k, v) {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+//Select 映射值为新的字典
+func (d {{.Dictionary}}) Select(f func({{.Key}}, {{.Type}}) {{.Type}}) {{.Dictionary}} {
+	var result = make({{.Dictionary}}, len(d))
+	for k, v := range d {
+		result[k] = f(k, v)
+	}
+	return result
+}
+
+//ForEach 遍历键值对，函数返回 false 时终止遍历
+func (d {{.Dictionary}}) ForEach(f func({{.Key}}, {{.Type}}) bool) {{.Dictionary}} {
+	for k, v := range d {
+		if !f(k, v) {
+			break
+		}
+	}
+	return d
+}
+
+//Count 计算（满足条件的）键值对数量
+func (d {{.Dictionary}}) Count(f ...func({{.Key}}, {{.Type}}) bool) int {
+	if len(f) == 0 {
+		return len(d)
+	}
+	return len(d.Where(f[0]))
+}
+
+//Merge 合并字典并创建新字典，键冲突时默认使用新值
+func (d {{.Dictionary}}) Merge(other {{.Dictionary}}, onConflict ...func(old, new {{.Type}}) {{.Type}}) {{.Dictionary}} {
+	var result = make({{.Dictionary}}, len(d)+len(other))
+	for k, v := range d {
+		result[k] = v
+	}
+	for k, v := range other {
+		if old, existed := result[k]; existed && len(onConflict) > 0 {
+			v = onConflict[0](old, v)
+		}
+		result[k] = v
+	}
+	return result
+}
+{{end}}`))
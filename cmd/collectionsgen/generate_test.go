@@ -0,0 +1,61 @@
+package main
+
+import (
+	"bytes"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGenerateMatchesExample(t *testing.T) {
+	var expected, err = ioutil.ReadFile("example/order_collections.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	src, err := generate(options{Package: "example", Types: []string{"Order"}, Key: "string", Args: "-type=Order -key=string"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(src, expected) {
+		t.Error("example/order_collections.go is outdated, run go generate ./cmd/collectionsgen/example")
+	}
+}
+
+func TestRunRejectsInvalidType(t *testing.T) {
+	if run("Order,*Item", "string", "", "example", nil) == nil {
+		t.Fail()
+	}
+}
+
+func TestRunRejectsInvalidKey(t *testing.T) {
+	for _, key := range []string{"map[", "", "[]string", "a b"} {
+		var err = run("Order", key, "", "example", nil)
+		if err == nil || !strings.Contains(err.Error(), "invalid key type") {
+			t.Errorf("key %q: %v", key, err)
+		}
+	}
+}
+
+func TestRunMultipleTypes(t *testing.T) {
+	var output = filepath.Join(t.TempDir(), "models_collections.go")
+	if err := run("Order, Item", "int", output, "models", []string{"-type=Order,Item", "-key=int"}); err != nil {
+		t.Fatal(err)
+	}
+	var src, err = ioutil.ReadFile(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var file *ast.File
+	if file, err = parser.ParseFile(token.NewFileSet(), output, src, 0); err != nil || file.Name.Name != "models" {
+		t.Fatal(err)
+	}
+	for _, declaration := range []string{"type (\n\t//OrderList", "\tItemList []Item", "\tItemDictionary map[int]Item", "func (l OrderList) GroupBy(key func(Order) int) map[int]OrderList"} {
+		if !strings.Contains(string(src), declaration) {
+			t.Errorf("missing %q", declaration)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+//collectionsgen 为给定类型生成不使用反射的强类型集合包装
+//
+//	//go:generate go run github.com/johnwiichang/collections/cmd/collectionsgen -type=Order -key=string
+//
+//会在当前目录生成 order_collections.go，其中包含 OrderList（[]Order）与 OrderDictionary（map[string]Order）。
+//键类型必须为内置类型或当前包中的类型。
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	var (
+		types  = flag.String("type", "", "comma-separated list of type names, required")
+		key    = flag.String("key", "string", "key type of the generated dictionaries")
+		output = flag.String("output", "", "output file name, default <type>_collections.go")
+		pkg    = flag.String("package", "", "package name, default $GOPACKAGE or the package in the current directory")
+	)
+	flag.Parse()
+	if *types == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err := run(*types, *key, *output, *pkg, os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, "collectionsgen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(types, key, output, pkg string, args []string) error {
+	var names = strings.Split(types, ",")
+	for i := range names {
+		names[i] = strings.TrimSpace(names[i])
+		if !token.IsIdentifier(names[i]) {
+			return fmt.Errorf("invalid type name %q", names[i])
+		}
+	}
+	if key = strings.TrimSpace(key); !token.IsIdentifier(key) {
+		return fmt.Errorf("invalid key type %q", key)
+	}
+	if pkg == "" {
+		var err error
+		if pkg, err = packageName("."); err != nil {
+			return err
+		}
+	}
+	if output == "" {
+		output = strings.ToLower(names[0]) + "_collections.go"
+	}
+	var src, err = generate(options{Package: pkg, Types: names, Key: key, Args: strings.Join(args, " ")})
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(output, src, 0644)
+}
+
+//packageName 获取包名，优先使用 go generate 设置的 $GOPACKAGE
+func packageName(dir string) (string, error) {
+	if name := os.Getenv("GOPACKAGE"); name != "" {
+		return name, nil
+	}
+	var pkgs, err = parser.ParseDir(token.NewFileSet(), dir, func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, parser.PackageClauseOnly)
+	if err != nil {
+		return "", err
+	}
+	for name := range pkgs {
+		return name, nil
+	}
+	var abs, _ = filepath.Abs(dir)
+	return "", fmt.Errorf("no Go package found in %s, use -package", abs)
+}
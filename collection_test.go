@@ -1,6 +1,8 @@
 package collections_test
 
 import (
+	"errors"
+	"reflect"
 	"testing"
 
 	"github.com/johnwiichang/collections"
@@ -28,3 +30,45 @@ func EstimateFail(t *testing.T, function func(*testing.T)) {
 	}()
 	function(t)
 }
+
+func TestFromString(t *testing.T) {
+	var runes = collections.From("héllo").List()
+	if runes.Count() != 5 || runes.Distinct().ToString() != "hélo" {
+		t.Fail()
+	}
+	if collections.From("héllo").Bytes().Count() != 6 || collections.From("héllo").Bytes().ToString() != "héllo" {
+		t.Fail()
+	}
+	var words = collections.From("a,b,,c").Split(",").Where(func(s string) bool { return s != "" })
+	if words.JoinString("-") != "a-b-c" || runes.JoinString(" ") != "h é l l o" {
+		t.Fail()
+	}
+	EstimateFail(t, func(*testing.T) {
+		collections.From([]int{1}).Bytes()
+	})
+	if collections.From("héllo").Bytes().JoinString("-") != "h-é-l-l-o" {
+		t.Fail()
+	}
+	if collections.From([]byte{'a', 0xff, 'b'}).List().JoinString(",") != "a,\xff,b" {
+		t.Fail()
+	}
+	EstimateFail(t, func(*testing.T) {
+		collections.From([]int{1}).List().ToString()
+	})
+	var err = recoverError(func() { collections.From([]int{1}).List().JoinString(",") })
+	var mismatched *collections.TypeNotCompatible
+	if !errors.As(err, &mismatched) || mismatched.Actually != reflect.TypeOf([]int{}) {
+		t.Fail()
+	}
+	for _, convert := range []func(){
+		func() { collections.From(map[string]int{}).List() },
+		func() { collections.From([]int{}).Dictionary() },
+		func() { collections.From(1).Bytes() },
+		func() { collections.From(1).Split(",") },
+		func() { collections.From([]int{}).Graph() },
+	} {
+		if !errors.Is(recoverError(convert), collections.ErrTypeNotCompatible) {
+			t.Fail()
+		}
+	}
+}
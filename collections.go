@@ -2,6 +2,7 @@ package collections
 
 import (
 	"reflect"
+	"strings"
 )
 
 type (
@@ -9,7 +10,17 @@ type (
 
 	Collections interface {
 		List() List
+		Bytes() List
+		Split(sep string) List
 		Dictionary() Dictionary
+		SortedDictionary(less ...interface{}) SortedDictionary
+		Cache(capacity int, options ...CacheOptions) Cache
+		Trie() Trie
+		Graph() Graph
+		Queue(bound ...int) Queue
+		Stack(bound ...int) Stack
+		Deque(bound ...int) Deque
+		RingBuffer(capacity int, policy ...RingPolicy) RingBuffer
 	}
 )
 
@@ -24,10 +35,15 @@ func (collections *collections) Value() *reflect.Value {
 
 //List 获取 List 集合
 //如果类型不为 List，那么会抛出 panic 异常。如果给出数组，将会自动转换为 Slice（如果不可求址则拷贝）。
+//如果给出字符串，将会按 rune 拆分为 []rune。
 func (collections *collections) List() List {
 	var kind = collections.Value().Kind()
+	if kind == reflect.String {
+		var value = reflect.ValueOf([]rune(collections.Value().String()))
+		return &list{t: value.Type(), value: &value}
+	}
 	if kind != reflect.Slice && kind != reflect.Array {
-		panic(throwTypeNotCompatiable("List", collections.Value().Type()).Error())
+		panic(throwTypeNotCompatiable("List", collections.Value().Type()))
 	}
 	var value = collections.Value()
 	if kind == reflect.Array {
@@ -43,9 +59,100 @@ func (collections *collections) List() List {
 	return &list{t: value.Type(), value: value}
 }
 
+//Bytes 将字符串按字节拆分为 []byte 的 List 集合
+//如果类型不为字符串，那么会抛出 panic 异常。
+func (collections *collections) Bytes() List {
+	if collections.Value().Kind() != reflect.String {
+		panic(throwTypeNotCompatiable("string", collections.Value().Type()))
+	}
+	var value = reflect.ValueOf([]byte(collections.Value().String()))
+	return &list{t: value.Type(), value: &value}
+}
+
+//Split 将字符串按分隔符拆分为 []string 的 List 集合
+//分隔符为空时按 UTF-8 字符拆分（与 strings.Split 一致）。如果类型不为字符串，那么会抛出 panic 异常。
+func (collections *collections) Split(sep string) List {
+	if collections.Value().Kind() != reflect.String {
+		panic(throwTypeNotCompatiable("string", collections.Value().Type()))
+	}
+	var value = reflect.ValueOf(strings.Split(collections.Value().String(), sep))
+	return &list{t: value.Type(), value: &value}
+}
+
 func (collections *collections) Dictionary() Dictionary {
 	if collections.Value().Kind() != reflect.Map {
-		panic(throwTypeNotCompatiable("Dictionary", collections.Value().Type()).Error())
+		panic(throwTypeNotCompatiable("Dictionary", collections.Value().Type()))
 	}
 	return &dictionary{t: collections.Value().Type(), value: collections.Value()}
 }
+
+//SortedDictionary 获取按键排序的 SortedDictionary 集合
+//可以给出键的比较函数 func(K, K) bool 或键选择函数，有序类型的键可以省略。
+func (collections *collections) SortedDictionary(less ...interface{}) SortedDictionary {
+	var dict = collections.Dictionary().(*dictionary)
+	var sd = newSortedDictionary(dict.t, makeLess("SortedDictionary", dict.t.Key(), less...))
+	for _, key := range dict.value.MapKeys() {
+		sd.set(key, dict.value.MapIndex(key))
+	}
+	return sd
+}
+
+//Cache 获取有界缓存 Cache 集合（容量为 0 时不限，为负数时抛出 ArgumentIsInvalid 异常）
+//映射中已有的元素会按遍历顺序写入缓存。
+func (collections *collections) Cache(capacity int, options ...CacheOptions) Cache {
+	var dict = collections.Dictionary().(*dictionary)
+	var c = newCache(dict.t, capacity, options...)
+	for _, key := range dict.value.MapKeys() {
+		c.Set(key.Interface(), dict.value.MapIndex(key).Interface())
+	}
+	return c
+}
+
+//Trie 获取前缀树 Trie 集合
+//支持键为字符串的映射，以及字符串的切片或数组（值为 true）。
+func (collections *collections) Trie() Trie {
+	if collections.Value().Kind() == reflect.Map {
+		return toTrie(collections.Dictionary())
+	}
+	return collections.List().ToTrie()
+}
+
+//Graph 从邻接表映射（map[K][]K）获取有向图 Graph 集合
+//如果类型不为邻接表映射，那么会抛出 panic 异常。
+func (collections *collections) Graph() Graph {
+	if collections.Value().Kind() != reflect.Map {
+		panic(throwTypeNotCompatiable("Graph", collections.Value().Type()))
+	}
+	return toGraph(*collections.Value())
+}
+
+//Queue 获取先进先出的 Queue 集合
+//可以给出容量上限（默认不限），元素按 List 顺序入队。
+func (collections *collections) Queue(bound ...int) Queue {
+	return &queue{fromList(collections.List(), bound...)}
+}
+
+//Stack 获取后进先出的 Stack 集合
+//可以给出容量上限（默认不限），元素按 List 顺序入栈（最后一个元素位于栈顶）。
+func (collections *collections) Stack(bound ...int) Stack {
+	return &stack{fromList(collections.List(), bound...)}
+}
+
+//Deque 获取双端队列 Deque 集合
+//可以给出容量上限（默认不限），元素按 List 顺序从队尾插入。
+func (collections *collections) Deque(bound ...int) Deque {
+	return fromList(collections.List(), bound...)
+}
+
+//RingBuffer 获取固定容量的环形缓冲区 RingBuffer 集合（默认覆盖最旧的元素）
+//元素按 List 顺序写入，Reject 策略下元素数量超出容量会抛出 panic 异常。
+func (collections *collections) RingBuffer(capacity int, policy ...RingPolicy) RingBuffer {
+	var lst = collections.List().(*list)
+	var rb = newRingBuffer(lst.t, capacity, append(policy, Overwrite)[0])
+	for i := 0; i < lst.value.Len(); i++ {
+		if !rb.push(lst.value.Index(i)) {
+			panic(throwCollectionIsFull(rb.t, capacity))
+		}
+	}
+	return rb
+}
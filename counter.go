@@ -0,0 +1,220 @@
+package collections
+
+import (
+	"reflect"
+	"sort"
+)
+
+type (
+	Counter interface {
+		Add(key interface{}, n ...int) Counter
+		Get(key interface{}) int
+		Remove(key interface{}) bool
+		Total() int
+		MostCommon(k ...int) List
+		Keys() List
+		Plus(c Counter) Counter
+		Subtract(c Counter) Counter
+		Union(c Counter) Counter
+		Intersect(c Counter) Counter
+		Dictionary(less ...interface{}) SortedDictionary
+
+		Type() reflect.Type
+	}
+
+	counting struct {
+		key   reflect.Value
+		count int
+	}
+
+	//counter 计数集合（多重集），计数不为正的键会被移除
+	//键的比较使用 EqualsTo* 钩子，没有钩子与注册比较函数的基础类型键使用 index 直接查找。
+	counter struct {
+		t      reflect.Type
+		counts []*counting
+		index  keyIndex
+	}
+)
+
+func newCounter(kt reflect.Type) *counter {
+	return &counter{t: reflect.MapOf(kt, types.Int), index: newKeyIndex(kt)}
+}
+
+func (c *counter) Type() reflect.Type {
+	return c.t
+}
+
+//find 查找键对应的计数位置，不存在时返回 -1
+func (c *counter) find(key reflect.Value) int {
+	if c.index != nil {
+		if index, existed := c.index[key.Interface()]; existed {
+			return index
+		}
+		return -1
+	}
+	for index, item := range c.counts {
+		if valueCompare(item.key, key) {
+			return index
+		}
+	}
+	return -1
+}
+
+func (c *counter) add(key reflect.Value, n int) {
+	var index = c.find(key)
+	if index < 0 {
+		if n > 0 {
+			c.insert(key, n)
+		}
+		return
+	}
+	if c.counts[index].count += n; c.counts[index].count <= 0 {
+		c.remove(index)
+	}
+}
+
+//insert 追加新的键
+func (c *counter) insert(key reflect.Value, n int) {
+	if c.index != nil {
+		c.index[key.Interface()] = len(c.counts)
+	}
+	c.counts = append(c.counts, &counting{key: key, count: n})
+}
+
+//remove 移除位置上的键
+func (c *counter) remove(index int) {
+	c.counts = append(c.counts[:index], c.counts[index+1:]...)
+	if c.index != nil {
+		c.index.removeAt(index)
+	}
+}
+
+//copy 拷贝计数集合
+func (c *counter) copy() *counter {
+	var newcounter = newCounter(c.t.Key())
+	for _, item := range c.counts {
+		newcounter.insert(item.key, item.count)
+	}
+	return newcounter
+}
+
+//other 校验另一个计数集合的键类型
+func (c *counter) other(other Counter) *counter {
+	if err := typeRequired(other.Type(), c.t); err != nil {
+		panic(err)
+	}
+	return other.(*counter)
+}
+
+//Add 增加键的计数（默认为 1，可以为负数）
+func (c *counter) Add(key interface{}, n ...int) Counter {
+	c.add(convertTo(key, c.t.Key()), append(n, 1)[0])
+	return c
+}
+
+func (c *counter) Get(key interface{}) int {
+	if index := c.find(convertTo(key, c.t.Key())); index >= 0 {
+		return c.counts[index].count
+	}
+	return 0
+}
+
+func (c *counter) Remove(key interface{}) bool {
+	var index = c.find(convertTo(key, c.t.Key()))
+	if index < 0 {
+		return false
+	}
+	c.remove(index)
+	return true
+}
+
+//Total 获取全部计数之和
+func (c *counter) Total() (total int) {
+	for _, item := range c.counts {
+		total += item.count
+	}
+	return
+}
+
+//MostCommon 按计数从多到少获取前 k 个键（不给出时获取全部键，计数相同时保持插入顺序）
+func (c *counter) MostCommon(k ...int) List {
+	var counts = append([]*counting{}, c.counts...)
+	sort.SliceStable(counts, func(i, j int) bool {
+		return counts[i].count > counts[j].count
+	})
+	if len(k) > 0 && k[0] >= 0 && k[0] < len(counts) {
+		counts = counts[:k[0]]
+	}
+	var keys = newList(reflect.SliceOf(c.t.Key()), len(counts))
+	for index, item := range counts {
+		keys.value.Index(index).Set(item.key)
+	}
+	return keys
+}
+
+//Keys 按插入顺序获取键集
+func (c *counter) Keys() List {
+	var keys = newList(reflect.SliceOf(c.t.Key()), len(c.counts))
+	for index, item := range c.counts {
+		keys.value.Index(index).Set(item.key)
+	}
+	return keys
+}
+
+//Plus 计数相加
+func (c *counter) Plus(other Counter) Counter {
+	var newcounter = c.copy()
+	for _, item := range c.other(other).counts {
+		newcounter.add(item.key, item.count)
+	}
+	return newcounter
+}
+
+//Subtract 计数相减（仅保留计数为正的键）
+func (c *counter) Subtract(other Counter) Counter {
+	var newcounter = c.copy()
+	for _, item := range c.other(other).counts {
+		if newcounter.find(item.key) >= 0 {
+			newcounter.add(item.key, -item.count)
+		}
+	}
+	return newcounter
+}
+
+//Union 取两者计数的最大值
+func (c *counter) Union(other Counter) Counter {
+	var newcounter = c.copy()
+	for _, item := range c.other(other).counts {
+		if index := newcounter.find(item.key); index < 0 {
+			newcounter.add(item.key, item.count)
+		} else if newcounter.counts[index].count < item.count {
+			newcounter.counts[index].count = item.count
+		}
+	}
+	return newcounter
+}
+
+//Intersect 取两者计数的最小值（仅保留两者均存在的键）
+func (c *counter) Intersect(other Counter) Counter {
+	var o, newcounter = c.other(other), newCounter(c.t.Key())
+	for _, item := range c.counts {
+		if index := o.find(item.key); index >= 0 {
+			var count = item.count
+			if o.counts[index].count < count {
+				count = o.counts[index].count
+			}
+			newcounter.add(item.key, count)
+		}
+	}
+	return newcounter
+}
+
+//Dictionary 转换为按键排序的 SortedDictionary 集合
+//可以给出键的比较函数，有序类型的键可以省略。
+func (c *counter) Dictionary(less ...interface{}) SortedDictionary {
+	var sd = newSortedDictionary(c.t, makeLess("Counter.Dictionary", c.t.Key(), less...))
+	for _, item := range c.counts {
+		sd.set(item.key, reflect.ValueOf(item.count))
+	}
+	return sd
+}
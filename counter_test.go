@@ -0,0 +1,72 @@
+package collections_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/johnwiichang/collections"
+)
+
+func TestCounter(t *testing.T) {
+	var counter = collections.From("mississippi").List().ToCounter()
+	if counter.Get('s') != 4 || counter.Get('m') != 1 || counter.Total() != 11 {
+		t.Fail()
+	}
+	if !reflect.DeepEqual(counter.MostCommon(2).Slice(), []rune{'i', 's'}) {
+		t.Fail()
+	}
+	counter.Add('m', 2).Add('p', -2)
+	if counter.Get('m') != 3 || counter.Get('p') != 0 || counter.Keys().Contains('p') {
+		t.Fail()
+	}
+	if !reflect.DeepEqual(counter.Dictionary().Keys().ToString(), "ims") {
+		t.Fail()
+	}
+}
+
+func TestCounterArithmetic(t *testing.T) {
+	var a = collections.From([]string{"a", "a", "a", "b"}).List().ToCounter()
+	var b = collections.From([]string{"a", "b", "b", "c"}).List().ToCounter()
+	var counts = func(c collections.Counter) interface{} { return c.Dictionary().Map() }
+	if !reflect.DeepEqual(counts(a.Plus(b)), map[string]int{"a": 4, "b": 3, "c": 1}) {
+		t.Fail()
+	}
+	if !reflect.DeepEqual(counts(a.Subtract(b)), map[string]int{"a": 2}) {
+		t.Fail()
+	}
+	if !reflect.DeepEqual(counts(a.Union(b)), map[string]int{"a": 3, "b": 2, "c": 1}) {
+		t.Fail()
+	}
+	if !reflect.DeepEqual(counts(a.Intersect(b)), map[string]int{"a": 1, "b": 1}) {
+		t.Fail()
+	}
+	var byParity = slices.Number.CountBy(func(n int) bool { return n%2 == 0 })
+	if byParity.Get(true) != 2 || byParity.Get(false) != 3 {
+		t.Fail()
+	}
+	EstimateFail(t, func(*testing.T) {
+		a.Plus(byParity)
+	})
+}
+
+func TestCounterRemoval(t *testing.T) {
+	var counter, expected = collections.From([]int{}).List().ToCounter(), map[int]int{}
+	for i := 0; i < 3000; i++ {
+		counter.Add(i%97, i%3-1)
+		if expected[i%97] += i%3 - 1; expected[i%97] <= 0 {
+			delete(expected, i%97)
+		}
+		if i%11 == 0 {
+			counter.Remove(i % 89)
+			delete(expected, i%89)
+		}
+	}
+	for key := 0; key < 97; key++ {
+		if counter.Get(key) != expected[key] {
+			t.Fatalf("unexpected count for %d", key)
+		}
+	}
+	if counter.Keys().Count() != len(expected) {
+		t.Fail()
+	}
+}
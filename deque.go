@@ -0,0 +1,341 @@
+package collections
+
+import (
+	"reflect"
+)
+
+type (
+	Deque interface {
+		PushFront(elements ...interface{}) Deque
+		PushBack(elements ...interface{}) Deque
+		TryPushFront(element interface{}) bool
+		TryPushBack(element interface{}) bool
+		PopFront() interface{}
+		PopBack() interface{}
+		TryPopFront() (interface{}, bool)
+		TryPopBack() (interface{}, bool)
+		PeekFront() interface{}
+		PeekBack() interface{}
+		Len() int
+		Iterator() Iterator
+		List() List
+		Strict(strict ...bool) Deque
+
+		Type() reflect.Type
+	}
+
+	Queue interface {
+		Push(elements ...interface{}) Queue
+		TryPush(element interface{}) bool
+		Pop() interface{}
+		TryPop() (interface{}, bool)
+		Peek() interface{}
+		Len() int
+		Iterator() Iterator
+		List() List
+		Strict(strict ...bool) Queue
+
+		Type() reflect.Type
+	}
+
+	Stack interface {
+		Push(elements ...interface{}) Stack
+		TryPush(element interface{}) bool
+		Pop() interface{}
+		TryPop() (interface{}, bool)
+		Peek() interface{}
+		Len() int
+		Iterator() Iterator
+		List() List
+		Strict(strict ...bool) Stack
+
+		Type() reflect.Type
+	}
+
+	//deque 基于环形缓冲区的双端队列，bound 为 0 时不限容量
+	deque struct {
+		t      reflect.Type
+		buffer reflect.Value
+
+		head, size, bound int
+		strictness
+	}
+
+	queue struct{ *deque }
+	stack struct{ *deque }
+)
+
+func newDeque(t reflect.Type, bound ...int) *deque {
+	var d = &deque{t: t, bound: append(bound, 0)[0]}
+	d.buffer = reflect.MakeSlice(t, 0, 0)
+	return d
+}
+
+//fromList 按顺序将 List 中的元素压入新的双端队列
+func fromList(l List, bound ...int) *deque {
+	var lst, d = l.(*list), newDeque(l.Type(), bound...)
+	d.strictness = lst.strictness
+	for i := 0; i < lst.value.Len(); i++ {
+		d.pushBack(lst.value.Index(i))
+	}
+	return d
+}
+
+func (d *deque) Type() reflect.Type {
+	return d.t
+}
+
+func (d *deque) Len() int {
+	return d.size
+}
+
+//index 获取逻辑位置上的元素
+func (d *deque) index(i int) reflect.Value {
+	return d.buffer.Index((d.head + i) % d.buffer.Len())
+}
+
+func (d *deque) full() bool {
+	return d.bound > 0 && d.size >= d.bound
+}
+
+//grow 缓冲区已满时扩容（倍增，不超过容量上限）
+func (d *deque) grow() {
+	if d.size < d.buffer.Len() {
+		return
+	}
+	if d.full() {
+		panic(throwCollectionIsFull(d.t, d.bound))
+	}
+	var capacity = d.size * 2
+	if capacity < 4 {
+		capacity = 4
+	}
+	if d.bound > 0 && capacity > d.bound {
+		capacity = d.bound
+	}
+	var buffer = reflect.MakeSlice(d.t, capacity, capacity)
+	for i := 0; i < d.size; i++ {
+		buffer.Index(i).Set(d.index(i))
+	}
+	d.buffer, d.head = buffer, 0
+}
+
+func (d *deque) pushBack(value reflect.Value) {
+	d.grow()
+	d.index(d.size).Set(value)
+	d.size++
+}
+
+func (d *deque) pushFront(value reflect.Value) {
+	d.grow()
+	d.head = (d.head - 1 + d.buffer.Len()) % d.buffer.Len()
+	d.buffer.Index(d.head).Set(value)
+	d.size++
+}
+
+//take 取出逻辑位置上的元素并清空原位置，避免缓冲区持有引用
+func (d *deque) take(i int) reflect.Value {
+	var slot, value = d.index(i), reflect.New(d.t.Elem()).Elem()
+	value.Set(slot)
+	slot.Set(reflect.Zero(d.t.Elem()))
+	return value
+}
+
+func (d *deque) popFront() (value reflect.Value, ok bool) {
+	if ok = d.size > 0; ok {
+		value = d.take(0)
+		d.head = (d.head + 1) % d.buffer.Len()
+		d.size--
+	}
+	return
+}
+
+func (d *deque) popBack() (value reflect.Value, ok bool) {
+	if ok = d.size > 0; ok {
+		value = d.take(d.size - 1)
+		d.size--
+	}
+	return
+}
+
+//must 解包弹出结果，集合为空时抛出 panic 异常
+func (d *deque) must(value reflect.Value, ok bool) interface{} {
+	if !ok {
+		panic(throwCollectionIsEmpty(d.t))
+	}
+	return value.Interface()
+}
+
+//Strict 为当前集合设置严格类型匹配模式（不给出参数时开启），影响写入的元素，由当前集合产生的 List 会继承该设置
+func (d *deque) Strict(strict ...bool) Deque {
+	d.setStrict(strict)
+	return d
+}
+
+func (d *deque) PushFront(elements ...interface{}) Deque {
+	for _, element := range elements {
+		d.pushFront(d.convertTo(element, d.t.Elem()))
+	}
+	return d
+}
+
+func (d *deque) PushBack(elements ...interface{}) Deque {
+	for _, element := range elements {
+		d.pushBack(d.convertTo(element, d.t.Elem()))
+	}
+	return d
+}
+
+//TryPushFront 在队首插入元素，容量已满时返回 false
+func (d *deque) TryPushFront(element interface{}) bool {
+	if d.full() {
+		return false
+	}
+	d.PushFront(element)
+	return true
+}
+
+//TryPushBack 在队尾插入元素，容量已满时返回 false
+func (d *deque) TryPushBack(element interface{}) bool {
+	if d.full() {
+		return false
+	}
+	d.PushBack(element)
+	return true
+}
+
+func (d *deque) PopFront() interface{} {
+	return d.must(d.popFront())
+}
+
+func (d *deque) PopBack() interface{} {
+	return d.must(d.popBack())
+}
+
+func (d *deque) TryPopFront() (interface{}, bool) {
+	if value, ok := d.popFront(); ok {
+		return value.Interface(), true
+	}
+	return nil, false
+}
+
+func (d *deque) TryPopBack() (interface{}, bool) {
+	if value, ok := d.popBack(); ok {
+		return value.Interface(), true
+	}
+	return nil, false
+}
+
+func (d *deque) PeekFront() interface{} {
+	return d.must(d.peek(0))
+}
+
+func (d *deque) PeekBack() interface{} {
+	return d.must(d.peek(d.size - 1))
+}
+
+func (d *deque) peek(i int) (reflect.Value, bool) {
+	if d.size == 0 {
+		return reflect.Value{}, false
+	}
+	return d.index(i), true
+}
+
+//Iterator 从队首到队尾遍历
+func (d *deque) Iterator() Iterator {
+	var i int
+	return newIterator(func() (value reflect.Value, ok bool) {
+		if ok = i < d.size; ok {
+			value = d.index(i)
+			i++
+		}
+		return
+	})
+}
+
+//List 按从队首到队尾的顺序拷贝为 List 集合
+func (d *deque) List() List {
+	var newlist = newList(d.t, d.size)
+	newlist.strictness = d.strictness
+	for i := 0; i < d.size; i++ {
+		newlist.value.Index(i).Set(d.index(i))
+	}
+	return newlist
+}
+
+func (q *queue) Strict(strict ...bool) Queue {
+	q.setStrict(strict)
+	return q
+}
+
+func (q *queue) Push(elements ...interface{}) Queue {
+	q.PushBack(elements...)
+	return q
+}
+
+func (q *queue) TryPush(element interface{}) bool {
+	return q.TryPushBack(element)
+}
+
+func (q *queue) Pop() interface{} {
+	return q.PopFront()
+}
+
+func (q *queue) TryPop() (interface{}, bool) {
+	return q.TryPopFront()
+}
+
+func (q *queue) Peek() interface{} {
+	return q.PeekFront()
+}
+
+func (s *stack) Strict(strict ...bool) Stack {
+	s.setStrict(strict)
+	return s
+}
+
+func (s *stack) Push(elements ...interface{}) Stack {
+	s.PushBack(elements...)
+	return s
+}
+
+func (s *stack) TryPush(element interface{}) bool {
+	return s.TryPushBack(element)
+}
+
+func (s *stack) Pop() interface{} {
+	return s.PopBack()
+}
+
+func (s *stack) TryPop() (interface{}, bool) {
+	return s.TryPopBack()
+}
+
+func (s *stack) Peek() interface{} {
+	return s.PeekBack()
+}
+
+//Iterator 从栈顶到栈底遍历
+func (s *stack) Iterator() Iterator {
+	var i = s.size
+	return newIterator(func() (value reflect.Value, ok bool) {
+		if i > s.size {
+			i = s.size
+		}
+		if ok = i > 0; ok {
+			i--
+			value = s.index(i)
+		}
+		return
+	})
+}
+
+//List 按从栈顶到栈底（即弹出顺序）拷贝为 List 集合
+func (s *stack) List() List {
+	var newlist = newList(s.t, s.size)
+	newlist.strictness = s.strictness
+	for i := 0; i < s.size; i++ {
+		newlist.value.Index(i).Set(s.index(s.size - 1 - i))
+	}
+	return newlist
+}
@@ -0,0 +1,113 @@
+package collections_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/johnwiichang/collections"
+)
+
+func TestQueue(t *testing.T) {
+	var queue = collections.From([]int{1, 2, 3}).Queue()
+	queue.Push(4, 5)
+	if queue.Peek() != 1 || queue.Pop() != 1 || queue.Len() != 4 {
+		t.Fail()
+	}
+	if !reflect.DeepEqual(queue.List().Slice(), []int{2, 3, 4, 5}) {
+		t.Fail()
+	}
+	for queue.Len() > 0 {
+		queue.Pop()
+	}
+	if _, ok := queue.TryPop(); ok {
+		t.Fail()
+	}
+	EstimateFail(t, func(*testing.T) {
+		queue.Pop()
+	})
+	EstimateFail(t, func(*testing.T) {
+		queue.Push("1")
+	})
+}
+
+func TestStack(t *testing.T) {
+	var stack = collections.From([]int{1, 2, 3}).Stack(4)
+	if !stack.TryPush(4) || stack.TryPush(5) {
+		t.Fail()
+	}
+	if stack.Peek() != 4 || stack.Pop() != 4 {
+		t.Fail()
+	}
+	var items []int
+	for it := stack.Iterator(); it.Next(); {
+		items = append(items, it.Value().(int))
+	}
+	if !reflect.DeepEqual(items, []int{3, 2, 1}) || !reflect.DeepEqual(stack.List().Slice(), items) {
+		t.Fail()
+	}
+	EstimateFail(t, func(*testing.T) {
+		collections.From([]int{1, 2, 3}).Stack(2)
+	})
+}
+
+func TestDeque(t *testing.T) {
+	var deque = collections.From([]int{}).Deque()
+	for i := 0; i < 10; i++ {
+		deque.PushFront(-i).PushBack(i)
+	}
+	if deque.Len() != 20 || deque.PeekFront() != -9 || deque.PeekBack() != 9 {
+		t.Fail()
+	}
+	if deque.PopBack() != 9 || deque.PopFront() != -9 {
+		t.Fail()
+	}
+	if deque.List().Where(func(n int) bool { return n < 0 }).Count() != 8 {
+		t.Fail()
+	}
+}
+
+func TestRingBuffer(t *testing.T) {
+	var ring = collections.From([]float64{1, 2, 3, 4}).RingBuffer(3)
+	if !ring.Full() || ring.Cap() != 3 || !reflect.DeepEqual(ring.List().Slice(), []float64{2, 3, 4}) {
+		t.Fail()
+	}
+	ring.Push(5.0)
+	var sum float64
+	ring.List().ForEach(func(n float64) { sum += n })
+	if sum != 12 || ring.List().Where(func(n float64) bool { return n > 3 }).Count() != 2 {
+		t.Fail()
+	}
+	if ring.PopOldest() != 3.0 || ring.Len() != 2 {
+		t.Fail()
+	}
+	var reject = collections.From([]int{1, 2}).RingBuffer(2, collections.Reject)
+	if reject.TryPush(3) || reject.PopOldest() != 1 || !reject.TryPush(3) {
+		t.Fail()
+	}
+	if !reflect.DeepEqual(reject.List().Slice(), []int{2, 3}) {
+		t.Fail()
+	}
+	EstimateFail(t, func(*testing.T) {
+		reject.Push(4)
+	})
+	EstimateFail(t, func(*testing.T) {
+		collections.From([]int{1, 2, 3}).RingBuffer(2, collections.Reject)
+	})
+	var err = recoverError(func() {
+		collections.From([]int{}).RingBuffer(0)
+	})
+	if !errors.Is(err, collections.ErrArgumentIsInvalid) {
+		t.Fail()
+	}
+}
+
+func TestRingBufferListIsolated(t *testing.T) {
+	var ring = collections.From([]int{3, 1, 2}).RingBuffer(4)
+	ring.List().Sort()
+	ring.List().Concat(collections.From([]int{7}).List())
+	ring.Push(4)
+	if !reflect.DeepEqual(ring.List().Slice(), []int{3, 1, 2, 4}) {
+		t.Fail()
+	}
+}
@@ -88,3 +88,41 @@ func TestDictionaryFunctionSignature(t *testing.T) {
 		dicts.NumberWithTrue.Merge(collections.From(map[int]bool{}).Dictionary(), func(a, b int) int { return a })
 	})
 }
+
+func TestDictionaryInvert(t *testing.T) {
+	var codes = collections.From(map[int]string{1: "a", 2: "b"}).Dictionary()
+	if !reflect.DeepEqual(codes.Invert().Map(), map[string]int{"a": 1, "b": 2}) {
+		t.Fail()
+	}
+	EstimateFail(t, func(*testing.T) {
+		dicts.NumberWithTrue.Invert()
+	})
+	EstimateFail(t, func(*testing.T) {
+		dicts.NumberWithTrue.ToBiMap()
+	})
+}
+
+func TestBiMap(t *testing.T) {
+	var bimap = collections.From(map[int]string{1: "a", 2: "b"}).Dictionary().ToBiMap()
+	if key, _ := bimap.GetByValue("b"); key != 2 {
+		t.Fail()
+	}
+	bimap.Set(1, "c")
+	if _, ok := bimap.GetByValue("a"); ok || bimap.TrySet(3, "c") || !bimap.TrySet(1, "c") {
+		t.Fail()
+	}
+	EstimateFail(t, func(*testing.T) {
+		bimap.Set(2, "c")
+	})
+	var inverse = bimap.Inverse()
+	inverse.Set("d", 4)
+	if value, _ := bimap.GetByKey(4); value != "d" || bimap.Count() != 3 {
+		t.Fail()
+	}
+	if !bimap.RemoveByValue("d") || inverse.Count() != 2 {
+		t.Fail()
+	}
+	if bimap.Where(func(k int) bool { return k > 1 }).Count() != 1 {
+		t.Fail()
+	}
+}
@@ -12,8 +12,14 @@ type (
 		Where(f interface{}) Dictionary
 		Count(f ...interface{}) int
 		ForEach(f interface{}) Dictionary
+		TryForEach(f interface{}) error
 		Select(f interface{}) Dictionary
 		Merge(d Dictionary, onConflict ...interface{}) Dictionary
+		Invert() Dictionary
+		ToBiMap() BiMap
+		ToImmutable() ImmutableDictionary
+		ToTrie() Trie
+		Strict(strict ...bool) Dictionary
 
 		Type() reflect.Type
 	}
@@ -21,6 +27,7 @@ type (
 	dictionary struct {
 		t     reflect.Type
 		value *reflect.Value
+		strictness
 	}
 )
 
@@ -34,6 +41,19 @@ func newDictionary(t reflect.Type, cap ...int) *dictionary {
 	return &dictionary{t: t, value: &value}
 }
 
+//derive 创建继承当前映射集合设置的新映射集合
+func (dict *dictionary) derive(t reflect.Type, cap ...int) *dictionary {
+	var newmap = newDictionary(t, cap...)
+	newmap.strictness = dict.strictness
+	return newmap
+}
+
+//Strict 为当前映射集合设置严格类型匹配模式（不给出参数时开启），由其产生的集合会继承该设置
+func (dict *dictionary) Strict(strict ...bool) Dictionary {
+	dict.setStrict(strict)
+	return dict
+}
+
 //Map 获取当前映射集合的值
 //可以传入具名 map 以确保符合预期，或者使用返回值进行类型断言。
 func (dict *dictionary) Map(m ...interface{}) interface{} {
@@ -41,7 +61,7 @@ func (dict *dictionary) Map(m ...interface{}) interface{} {
 		return dict.value.Interface()
 	}
 	val, dst := dict.value, reflect.Indirect(reflect.ValueOf(m[0]))
-	if err := typeRequired(dst.Type(), dict.t); err != nil {
+	if err := typeRequiredWith(dict.strictTypes(), dst.Type(), dict.t); err != nil {
 		panic(err)
 	}
 	for _, key := range val.MapKeys() {
@@ -55,6 +75,7 @@ func (dict *dictionary) Map(m ...interface{}) interface{} {
 func (dict *dictionary) Keys() List {
 	val := dict.value
 	var keys = newList(reflect.SliceOf(dict.t.Key()), val.Len())
+	keys.strictness = dict.strictness
 	for index, key := range val.MapKeys() {
 		keys.value.Index(index).Set(key)
 	}
@@ -66,6 +87,7 @@ func (dict *dictionary) Keys() List {
 func (dict *dictionary) Values() List {
 	val := dict.value
 	var values = newList(reflect.SliceOf(dict.t.Elem()), val.Len())
+	values.strictness = dict.strictness
 	for index, key := range val.MapKeys() {
 		values.value.Index(index).Set(val.MapIndex(key))
 	}
@@ -73,17 +95,14 @@ func (dict *dictionary) Values() List {
 }
 
 func (dict *dictionary) Where(f interface{}) Dictionary {
-	val, function := dict.value, reflect.ValueOf(f)
-	if err := typeRequired(function.Type(),
+	var val, function = dict.value, dict.lambda("Dictionary.Where", f,
 		newFunc(dict.t.Key())(types.Bool)(),
 		newFunc(dict.t.Key(), dict.t.Elem())(types.Bool)(),
-	); err != nil {
-		panic(err)
-	}
-	var newmap, numin = newDictionary(dict.t), function.Type().NumIn()
+	)
+	var newmap, numin = dict.derive(dict.t), function.Type().NumIn()
 	for _, key := range val.MapKeys() {
 		var args = []reflect.Value{key, val.MapIndex(key)}
-		if call(function, args[:numin]...)[0].Bool() {
+		if function.call(args[:numin]...)[0].Bool() {
 			newmap.value.SetMapIndex(key, args[1])
 		}
 	}
@@ -98,48 +117,49 @@ func (dict *dictionary) Count(f ...interface{}) int {
 }
 
 func (dict *dictionary) ForEach(f interface{}) Dictionary {
-	val, function := dict.value, reflect.ValueOf(f)
-	if err := typeRequired(function.Type(),
+	dict.each("Dictionary.ForEach", f)
+	return dict
+}
+
+//TryForEach 与 ForEach 相同，但遍历函数返回的 error、函数发生的 panic 与签名错误会作为错误返回
+func (dict *dictionary) TryForEach(f interface{}) (err error) {
+	defer recovered("Dictionary.TryForEach", &err)
+	return dict.each("Dictionary.TryForEach", f)
+}
+
+//each 遍历键值对，返回使遍历终止的 error
+func (dict *dictionary) each(operator string, f interface{}) error {
+	var val, function = dict.value, dict.lambda(operator, f,
 		newFunc(dict.t.Key())(types.AnyTypes)(),
 		newFunc(dict.t.Key(), dict.t.Elem())(types.AnyTypes)(),
-	); err != nil {
-		panic(err)
-	}
+	)
 	var numin = function.Type().NumIn()
 	for _, key := range val.MapKeys() {
 		var args = []reflect.Value{key, val.MapIndex(key)}
-		var back = call(function, args[:numin]...)
-		if len(back) > 0 {
-			var first, last = back[0], back[len(back)-1].Interface()
-			var stop = first.Kind() == reflect.Bool && !first.Bool()
-			if !stop && last != nil {
-				_, stop = last.(error)
-			}
-			if stop {
-				break
+		if back := function.call(args[:numin]...); stopped(back) {
+			if err := failed(back); err != nil {
+				return throwLambdaFailed(operator, key.Interface(), err)
 			}
+			break
 		}
 	}
-	return dict
+	return nil
 }
 
 func (dict *dictionary) Select(f interface{}) Dictionary {
-	val, function := dict.value, reflect.ValueOf(f)
-	var funct = function.Type()
-	if err := typeRequired(funct,
+	var val, function = dict.value, dict.lambda("Dictionary.Select", f,
 		newFunc(dict.t.Key())(types.AnyTypes)(),
 		newFunc(dict.t.Key(), dict.t.Elem())(types.AnyTypes)(),
-	); err != nil {
-		panic(err)
-	}
+	)
+	var funct = function.Type()
 	var kt, vt = dict.t.Key(), funct.Out(0)
 	if funct.NumOut() > 1 {
 		kt, vt = funct.Out(0), funct.Out(1)
 	}
-	var newmap, numin = newDictionary(reflect.MapOf(kt, vt), val.Len()), function.Type().NumIn()
+	var newmap, numin = dict.derive(reflect.MapOf(kt, vt), val.Len()), function.Type().NumIn()
 	dict.ForEach(func(k, v interface{}) {
 		var args = []reflect.Value{reflect.ValueOf(k), reflect.ValueOf(v)}
-		var back, key = call(function, args[:numin]...), args[0]
+		var back, key = function.call(args[:numin]...), args[0]
 		if len(back) > 1 {
 			key, back[0] = back[0], back[1]
 		}
@@ -149,7 +169,7 @@ func (dict *dictionary) Select(f interface{}) Dictionary {
 }
 
 func (dict *dictionary) copy() *dictionary {
-	var newdict = newDictionary(dict.t)
+	var newdict = dict.derive(dict.t)
 	dict.ForEach(func(k, v interface{}) {
 		newdict.value.SetMapIndex(reflect.ValueOf(k), reflect.ValueOf(v))
 	})
@@ -161,20 +181,14 @@ func (dict *dictionary) Type() reflect.Type {
 }
 
 func (dict *dictionary) Merge(d Dictionary, onConflict ...interface{}) Dictionary {
-	if err := typeRequired(d.Type(), dict.t); err != nil {
+	if err := typeRequiredWith(dict.strictTypes(), d.Type(), dict.t); err != nil {
 		panic(err)
 	}
-	var function reflect.Value
-	if len(onConflict) == 0 {
-		function = makeConflictHandler(d.Type().Elem(), 1)
-	} else {
-		function = reflect.ValueOf(onConflict[0])
-	}
-	var funct = function.Type()
-	if err := typeRequired(funct,
-		newFunc(dict.t.Elem(), types.AnyTypes)(dict.t.Elem())(),
-	); err != nil {
-		panic(err)
+	var function = makeConflictHandler(d.Type().Elem(), 1)
+	if len(onConflict) > 0 {
+		function = dict.lambda("Dictionary.Merge", onConflict[0],
+			newFunc(dict.t.Elem(), types.AnyTypes)(dict.t.Elem())(),
+		)
 	}
 	var newmap = dict.copy()
 	d.ForEach(func(k, v interface{}) {
@@ -183,17 +197,53 @@ func (dict *dictionary) Merge(d Dictionary, onConflict ...interface{}) Dictionar
 			newmap.value.SetMapIndex(key, reflect.ValueOf(v))
 		} else {
 			var old = newmap.value.MapIndex(key)
-			newmap.value.SetMapIndex(key, call(function, old, reflect.ValueOf(v))[0])
+			newmap.value.SetMapIndex(key, function.call(old, reflect.ValueOf(v))[0])
+		}
+	})
+	return newmap
+}
+
+//Invert 交换键与值
+//如果存在重复的值，那么会抛出 panic 异常。
+func (dict *dictionary) Invert() Dictionary {
+	return invert(dict)
+}
+
+//ToBiMap 转换为双向映射集合
+//如果存在重复的值，那么会抛出 panic 异常。
+func (dict *dictionary) ToBiMap() BiMap {
+	return newBiMap(dict)
+}
+
+//ToImmutable 拷贝为持久化的不可变映射集合
+func (dict *dictionary) ToImmutable() ImmutableDictionary {
+	return toImmutableDictionary(dict)
+}
+
+//ToTrie 转换为前缀树
+//如果键不为字符串，那么会抛出 panic 异常。
+func (dict *dictionary) ToTrie() Trie {
+	return toTrie(dict)
+}
+
+func invert(d Dictionary) *dictionary {
+	var t = d.Type()
+	var newmap = newDictionary(reflect.MapOf(t.Elem(), t.Key()), d.Count())
+	d.ForEach(func(k, v interface{}) {
+		var key, value = convertTo(v, t.Elem()), convertTo(k, t.Key())
+		if newmap.value.MapIndex(key).IsValid() {
+			panic(throwValueIsDuplicated(t, v))
 		}
+		newmap.value.SetMapIndex(key, value)
 	})
 	return newmap
 }
 
-func makeConflictHandler(t reflect.Type, startIndex int) reflect.Value {
+func makeConflictHandler(t reflect.Type, startIndex int) lambdaFunc {
 	var funct = reflect.FuncOf([]reflect.Type{t, t}, []reflect.Type{t}, false)
 	var funcbody = func(args []reflect.Value) []reflect.Value {
 		return args[startIndex : startIndex+1]
 	}
 	var function = reflect.MakeFunc(funct, funcbody)
-	return function
+	return lambdaFunc{Value: function}
 }
@@ -0,0 +1,168 @@
+package collections
+
+import (
+	"reflect"
+	"sync/atomic"
+)
+
+//EqualityStrategy 无钩子与注册比较函数时，不可直接比较（==）的值所使用的相等判定策略
+type EqualityStrategy int32
+
+const (
+	//StructuralEquality 逐元素、逐字段递归比较，嵌套的值同样会使用钩子与注册的比较函数
+	StructuralEquality EqualityStrategy = iota
+	//DeepEquality 使用 reflect.DeepEqual
+	DeepEquality
+	//ComparableOnly 仅允许可比较类型，否则抛出 ValueIsNotComparable 异常
+	ComparableOnly
+)
+
+var equalityStrategy = int32(StructuralEquality)
+
+//SetEqualityStrategy 设置全局相等判定策略，返回此前的策略
+func SetEqualityStrategy(strategy EqualityStrategy) EqualityStrategy {
+	return EqualityStrategy(atomic.SwapInt32(&equalityStrategy, int32(strategy)))
+}
+
+//visit 结构比较中的一对切片或映射
+type visit struct {
+	p1, p2 uintptr
+	t      reflect.Type
+}
+
+//fallbackEquals 对相同类型的值按当前策略进行判定
+func fallbackEquals(v1, v2 reflect.Value, visited map[visit]bool) bool {
+	switch EqualityStrategy(atomic.LoadInt32(&equalityStrategy)) {
+	case DeepEquality:
+		return reflect.DeepEqual(v1.Interface(), v2.Interface())
+	case ComparableOnly:
+		return comparableEquals(v1, v2)
+	default:
+		return structuralEquals(v1, v2, visited)
+	}
+}
+
+//comparableEquals 使用 == 比较，不可比较时（包括含有不可比较动态值的接口字段）抛出异常
+func comparableEquals(v1, v2 reflect.Value) (equal bool) {
+	if !v1.Type().Comparable() {
+		panic(throwValueIsNotComparable(v1.Type()))
+	}
+	defer func() {
+		if recover() != nil {
+			panic(throwValueIsNotComparable(v1.Type()))
+		}
+	}()
+	return v1.Interface() == v2.Interface()
+}
+
+//structuralEquals 递归比较切片、数组、映射与结构体
+//结构体含有未导出字段时整体退回 reflect.DeepEqual。与 reflect.DeepEqual 相同，再次遇到正在比较的切片或映射时视为相等，以免自引用的值无限递归。
+func structuralEquals(v1, v2 reflect.Value, visited map[visit]bool) bool {
+	switch v1.Kind() {
+	case reflect.Slice:
+		if v1.IsNil() != v2.IsNil() || v1.Len() != v2.Len() {
+			return false
+		} else if v1.Pointer() == v2.Pointer() {
+			return true
+		}
+		var revisited bool
+		if visited, revisited = revisit(visited, v1, v2); revisited {
+			return true
+		}
+		fallthrough
+	case reflect.Array:
+		for i := 0; i < v1.Len(); i++ {
+			if !valueCompareWith(v1.Index(i), v2.Index(i), visited) {
+				return false
+			}
+		}
+		return true
+	case reflect.Map:
+		if v1.IsNil() != v2.IsNil() || v1.Len() != v2.Len() {
+			return false
+		} else if v1.Pointer() == v2.Pointer() {
+			return true
+		}
+		var revisited bool
+		if visited, revisited = revisit(visited, v1, v2); revisited {
+			return true
+		}
+		for iter := v1.MapRange(); iter.Next(); {
+			var value = v2.MapIndex(iter.Key())
+			if !value.IsValid() || !valueCompareWith(iter.Value(), value, visited) {
+				return false
+			}
+		}
+		return true
+	case reflect.Struct:
+		for i := 0; i < v1.NumField(); i++ {
+			if !v1.Field(i).CanInterface() {
+				return reflect.DeepEqual(v1.Interface(), v2.Interface())
+			}
+		}
+		for i := 0; i < v1.NumField(); i++ {
+			if !valueCompareWith(v1.Field(i), v2.Field(i), visited) {
+				return false
+			}
+		}
+		return true
+	case reflect.Func:
+		if v1.IsNil() && v2.IsNil() {
+			return true
+		}
+	}
+	return comparableEquals(v1, v2)
+}
+
+//revisit 记录正在比较的一对切片或映射，返回更新后的记录以及该对是否已在比较中
+func revisit(visited map[visit]bool, v1, v2 reflect.Value) (map[visit]bool, bool) {
+	var key = visit{v1.Pointer(), v2.Pointer(), v1.Type()}
+	if visited == nil {
+		visited = map[visit]bool{}
+	} else if visited[key] {
+		return visited, true
+	}
+	visited[key] = true
+	return visited, false
+}
+
+//isNil 判断值是否为空（无效值或空的可空类型）
+func isNil(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Invalid:
+		return true
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice:
+		return v.IsNil()
+	}
+	return false
+}
+
+//keyIndex 键到位置的索引，用于可直接使用 Go map 比较的键类型
+type keyIndex map[interface{}]int
+
+//newKeyIndex 键类型为基础可比较类型且没有 EqualsTo* 钩子与注册的相等比较函数时创建索引，否则返回 nil（使用线性查找）
+func newKeyIndex(t reflect.Type) keyIndex {
+	switch t.Kind() {
+	case reflect.Bool, reflect.String, reflect.Ptr, reflect.Chan, reflect.UnsafePointer,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
+	default:
+		return nil
+	}
+	if getCompareHook(t, t) != nil || getEqualityComparer(t, t) != nil {
+		return nil
+	}
+	return keyIndex{}
+}
+
+//removeAt 移除位置上的键，并将其后的位置前移
+func (index keyIndex) removeAt(position int) {
+	for key, i := range index {
+		if i == position {
+			delete(index, key)
+		} else if i > position {
+			index[key] = i - 1
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package collections_test
+
+import (
+	"testing"
+
+	"github.com/johnwiichang/collections"
+)
+
+type Tagged struct {
+	Name string
+	Tags []string
+	Meta interface{}
+}
+
+func TestEqualityFallback(t *testing.T) {
+	var slices = collections.From([][]int{{1, 2}, {3}, {1, 2}, nil, {}}).List()
+	if slices.Distinct().Count() != 4 || !slices.Contains([]int{3}, []int{}) || slices.Any([]int{2, 1}) {
+		t.Fail()
+	}
+	if slices.First([]int{1, 2}) != 0 || slices.Last([]int{1, 2}) != 2 || slices.First(nil) != 3 {
+		t.Fail()
+	}
+	var tagged = collections.From([]Tagged{
+		{"a", []string{"x"}, []int{1}},
+		{"b", nil, map[string]int{"k": 1}},
+		{"a", []string{"x"}, []int{1}},
+	}).List()
+	var other = collections.From([]Tagged{{"b", nil, map[string]int{"k": 1}}}).List()
+	if tagged.Distinct().Count() != 2 || tagged.Intersect(other).Count() != 1 || tagged.Except(other).Count() != 2 {
+		t.Fail()
+	}
+	if tagged.Union(other).Count() != 2 {
+		t.Fail()
+	}
+	var maps = collections.From([]map[string][]int{{"a": {1}}, {"a": {1}}, {"a": {2}}}).List()
+	if maps.Distinct().Count() != 2 {
+		t.Fail()
+	}
+	defer collections.SetEqualityStrategy(collections.SetEqualityStrategy(collections.DeepEquality))
+	if tagged.Distinct().Count() != 2 || !maps.Contains(map[string][]int{"a": {2}}) {
+		t.Fail()
+	}
+	collections.SetEqualityStrategy(collections.ComparableOnly)
+	EstimateFail(t, func(t *testing.T) {
+		slices.Distinct()
+	})
+	EstimateFail(t, func(t *testing.T) {
+		tagged.Contains(Tagged{})
+	})
+	type point struct{ X, Y int }
+	if !collections.From([]point{{1, 2}}).List().Contains(point{1, 2}) {
+		t.Fail()
+	}
+}
+
+func TestEqualitySelfReference(t *testing.T) {
+	var a, b = []interface{}{1, nil}, []interface{}{1, nil}
+	a[1], b[1] = a, b
+	var m1, m2 = map[string]interface{}{"n": 1}, map[string]interface{}{"n": 1}
+	m1["self"], m2["self"] = m1, m2
+	if !collections.From([][]interface{}{a}).List().Contains(b) || !collections.From([]map[string]interface{}{m1}).List().Contains(m2) {
+		t.Fail()
+	}
+	var c = []interface{}{2, nil}
+	c[1] = c
+	if collections.From([][]interface{}{a}).List().Contains(c) {
+		t.Fail()
+	}
+}
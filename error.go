@@ -6,35 +6,261 @@ import (
 )
 
 type (
+	//sentinel 可用于 errors.Is 判断的错误类别
+	sentinel string
+
 	TypeNotCompatible struct {
 		Estimate string
 		Actually reflect.Type
+
+		//Operator 校验失败的操作（如 List.Select），非操作的函数参数时为空
+		Operator string
+		//Candidates 操作接受的全部签名
+		Candidates []reflect.Type
+		//Parameter 首个不匹配的函数输入位置（从 0 开始），无法确定时为 -1
+		Parameter int
+		Expected  reflect.Type
 	}
 
 	MethodHasNoImplement struct {
 		Method string
 		Type   reflect.Type
 	}
+
+	CollectionIsEmpty struct {
+		Type reflect.Type
+	}
+
+	CollectionIsFull struct {
+		Type     reflect.Type
+		Capacity int
+	}
+
+	HandleIsInvalid struct {
+		Type reflect.Type
+	}
+
+	IndexOutOfRange struct {
+		Index  int
+		Length int
+	}
+
+	//ArgumentIsInvalid 参数（或函数返回的值）超出允许的范围
+	ArgumentIsInvalid struct {
+		Argument string
+		Value    interface{}
+	}
+
+	CycleDetected struct {
+		Cycle interface{}
+	}
+
+	ValueIsDuplicated struct {
+		Type  reflect.Type
+		Value interface{}
+	}
+
+	ValueIsNotComparable struct {
+		Type reflect.Type
+	}
+
+	//LambdaPanicked 传入的函数发生 panic，Value 为 panic 的值
+	LambdaPanicked struct {
+		Operator string
+		Function reflect.Type
+		Value    interface{}
+	}
+
+	//LambdaFailed 遍历函数返回了非空 error 而提前终止，Position 为元素索引或键
+	LambdaFailed struct {
+		Operator string
+		Position interface{}
+		Err      error
+	}
 )
 
+var (
+	ErrTypeNotCompatible    error = sentinel("type is not compatible")
+	ErrMethodHasNoImplement error = sentinel("method has no implement")
+	ErrCollectionIsEmpty    error = sentinel("collection is empty")
+	ErrCollectionIsFull     error = sentinel("collection is full")
+	ErrHandleIsInvalid      error = sentinel("handle is invalid")
+	ErrIndexOutOfRange      error = sentinel("index is out of range")
+	ErrArgumentIsInvalid    error = sentinel("argument is invalid")
+	ErrCycleDetected        error = sentinel("cycle detected")
+	ErrValueIsDuplicated    error = sentinel("value is duplicated")
+	ErrValueIsNotComparable error = sentinel("value is not comparable")
+	ErrLambdaPanicked       error = sentinel("lambda panicked")
+	ErrLambdaFailed         error = sentinel("lambda failed")
+)
+
+func (s sentinel) Error() string {
+	return string(s)
+}
+
 func (tnc *TypeNotCompatible) Error() string {
-	return fmt.Sprintf(
-		"type '%s' is not compatible with '%s'",
-		tnc.Actually.String(), tnc.Estimate,
+	var message = fmt.Sprintf(
+		"type '%v' is not compatible with '%s'",
+		tnc.Actually, tnc.Estimate,
 	)
+	if tnc.Operator != "" {
+		message = fmt.Sprintf("%s: %s", tnc.Operator, message)
+	}
+	if actually := tnc.Actually; tnc.Parameter >= 0 && actually != nil && actually.Kind() == reflect.Func && (tnc.Parameter < actually.NumIn() || actually.IsVariadic()) {
+		message += fmt.Sprintf(
+			": parameter %d is '%s' but '%s' is passed",
+			tnc.Parameter, parameterType(actually, tnc.Parameter).String(), typeName(tnc.Expected),
+		)
+	}
+	return message
 }
 
 func (mhni *MethodHasNoImplement) Error() string {
 	return fmt.Sprintf(
-		"type '%s' does have any '%s' method",
+		"type '%s' does not have any '%s' method",
 		mhni.Type.String(), mhni.Method,
 	)
 }
 
+func (cie *CollectionIsEmpty) Error() string {
+	return fmt.Sprintf(
+		"collection of type '%s' is empty",
+		cie.Type.String(),
+	)
+}
+
+func (cif *CollectionIsFull) Error() string {
+	return fmt.Sprintf(
+		"collection of type '%s' is full (capacity %d)",
+		cif.Type.String(), cif.Capacity,
+	)
+}
+
+func (hii *HandleIsInvalid) Error() string {
+	return fmt.Sprintf(
+		"handle is not valid for collection of type '%s'",
+		hii.Type.String(),
+	)
+}
+
+func (ioor *IndexOutOfRange) Error() string {
+	return fmt.Sprintf(
+		"index %d is out of range with length %d",
+		ioor.Index, ioor.Length,
+	)
+}
+
+func (aii *ArgumentIsInvalid) Error() string {
+	return fmt.Sprintf("argument '%s' is invalid: %v", aii.Argument, aii.Value)
+}
+
+func (cd *CycleDetected) Error() string {
+	return fmt.Sprintf("cycle detected: %v", cd.Cycle)
+}
+
+func (vid *ValueIsDuplicated) Error() string {
+	return fmt.Sprintf(
+		"value '%v' is duplicated in '%s'",
+		vid.Value, vid.Type.String(),
+	)
+}
+
+func (vinc *ValueIsNotComparable) Error() string {
+	return fmt.Sprintf(
+		"values of type '%s' are not comparable",
+		vinc.Type.String(),
+	)
+}
+
+func (lp *LambdaPanicked) Error() string {
+	var message = fmt.Sprintf("lambda '%v' panicked: %v", lp.Function, lp.Value)
+	if lp.Operator != "" {
+		message = fmt.Sprintf("%s: %s", lp.Operator, message)
+	}
+	return message
+}
+
+//Unwrap 当 panic 的值为 error 时返回该错误
+func (lp *LambdaPanicked) Unwrap() error {
+	err, _ := lp.Value.(error)
+	return err
+}
+
+func (lf *LambdaFailed) Error() string {
+	return fmt.Sprintf("%s: lambda failed at %v: %v", lf.Operator, lf.Position, lf.Err)
+}
+
+func (lf *LambdaFailed) Unwrap() error {
+	return lf.Err
+}
+
+func (tnc *TypeNotCompatible) Is(target error) bool     { return target == ErrTypeNotCompatible }
+func (mhni *MethodHasNoImplement) Is(target error) bool { return target == ErrMethodHasNoImplement }
+func (cie *CollectionIsEmpty) Is(target error) bool     { return target == ErrCollectionIsEmpty }
+func (cif *CollectionIsFull) Is(target error) bool      { return target == ErrCollectionIsFull }
+func (hii *HandleIsInvalid) Is(target error) bool       { return target == ErrHandleIsInvalid }
+func (ioor *IndexOutOfRange) Is(target error) bool      { return target == ErrIndexOutOfRange }
+func (aii *ArgumentIsInvalid) Is(target error) bool     { return target == ErrArgumentIsInvalid }
+func (cd *CycleDetected) Is(target error) bool          { return target == ErrCycleDetected }
+func (vid *ValueIsDuplicated) Is(target error) bool     { return target == ErrValueIsDuplicated }
+func (vinc *ValueIsNotComparable) Is(target error) bool { return target == ErrValueIsNotComparable }
+func (lp *LambdaPanicked) Is(target error) bool         { return target == ErrLambdaPanicked }
+func (lf *LambdaFailed) Is(target error) bool           { return target == ErrLambdaFailed }
+
+//isCollectionError 判断是否为本包抛出的错误
+func isCollectionError(v interface{}) bool {
+	switch v.(type) {
+	case *TypeNotCompatible, *MethodHasNoImplement, *CollectionIsEmpty, *CollectionIsFull, *HandleIsInvalid,
+		*IndexOutOfRange, *ArgumentIsInvalid, *CycleDetected, *ValueIsDuplicated, *ValueIsNotComparable, *LambdaPanicked, *LambdaFailed:
+		return true
+	}
+	return false
+}
+
 func throwTypeNotCompatiable(target string, actually reflect.Type) error {
-	return &TypeNotCompatible{Estimate: target, Actually: actually}
+	return &TypeNotCompatible{Estimate: target, Actually: actually, Parameter: -1}
 }
 
 func throwMethodHasNoImplement(method string, t reflect.Type) error {
 	return &MethodHasNoImplement{Method: method, Type: t}
 }
+
+func throwCollectionIsEmpty(t reflect.Type) error {
+	return &CollectionIsEmpty{Type: t}
+}
+
+func throwCollectionIsFull(t reflect.Type, capacity int) error {
+	return &CollectionIsFull{Type: t, Capacity: capacity}
+}
+
+func throwHandleIsInvalid(t reflect.Type) error {
+	return &HandleIsInvalid{Type: t}
+}
+
+func throwValueIsDuplicated(t reflect.Type, value interface{}) error {
+	return &ValueIsDuplicated{Type: t, Value: value}
+}
+
+func throwIndexOutOfRange(index, length int) error {
+	return &IndexOutOfRange{Index: index, Length: length}
+}
+
+func throwArgumentIsInvalid(argument string, value interface{}) error {
+	return &ArgumentIsInvalid{Argument: argument, Value: value}
+}
+
+func throwCycleDetected(cycle interface{}) error {
+	return &CycleDetected{Cycle: cycle}
+}
+
+func throwValueIsNotComparable(t reflect.Type) error {
+	return &ValueIsNotComparable{Type: t}
+}
+
+func throwLambdaPanicked(function reflect.Type, value interface{}) error {
+	return &LambdaPanicked{Function: function, Value: value}
+}
+
+func throwLambdaFailed(operator string, position interface{}, err error) error {
+	return &LambdaFailed{Operator: operator, Position: position, Err: err}
+}
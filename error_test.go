@@ -0,0 +1,129 @@
+package collections_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/johnwiichang/collections"
+)
+
+func recoverError(function func()) (err error) {
+	defer func() {
+		err, _ = recover().(error)
+	}()
+	function()
+	return
+}
+
+func TestStructuredErrors(t *testing.T) {
+	var err = recoverError(func() {
+		collections.From([]int{1}).List().Select(func(i int, s string) string { return s })
+	})
+	var tnc *collections.TypeNotCompatible
+	if !errors.Is(err, collections.ErrTypeNotCompatible) || !errors.As(err, &tnc) {
+		t.FailNow()
+	}
+	if tnc.Operator != "List.Select" || len(tnc.Candidates) != 2 || tnc.Parameter != 1 || tnc.Expected != reflect.TypeOf(0) {
+		t.Fail()
+	}
+	if !strings.HasPrefix(err.Error(), "List.Select: type 'func(int, string) string'") {
+		t.Fail()
+	}
+	err = recoverError(func() {
+		collections.From([]struct{}{{}}).List().Sort()
+	})
+	if !errors.Is(err, collections.ErrMethodHasNoImplement) || !strings.Contains(err.Error(), "does not have any") {
+		t.Fail()
+	}
+	if errors.Is(err, collections.ErrTypeNotCompatible) {
+		t.Fail()
+	}
+	if (&collections.TypeNotCompatible{}).Error() == "" || (&collections.TypeNotCompatible{Actually: reflect.TypeOf(0), Parameter: 2}).Error() == "" {
+		t.Fail()
+	}
+}
+
+func TestLambdaErrors(t *testing.T) {
+	var numbers = collections.From([]int{1, 2, 3}).List()
+	var boom = errors.New("boom")
+	var err = numbers.TryForEach(func(i int) error {
+		if i == 2 {
+			return boom
+		}
+		return nil
+	})
+	var failed *collections.LambdaFailed
+	if !errors.Is(err, boom) || !errors.As(err, &failed) || failed.Operator != "List.TryForEach" || failed.Position != 1 {
+		t.Fail()
+	}
+	if numbers.TryForEach(func(i int) bool { return i < 2 }) != nil {
+		t.Fail()
+	}
+	err = numbers.TryForEach(func(i int) {
+		panic(boom)
+	})
+	var panicked *collections.LambdaPanicked
+	if !errors.Is(err, collections.ErrLambdaPanicked) || !errors.Is(err, boom) || !errors.As(err, &panicked) || panicked.Operator != "List.TryForEach" {
+		t.Fail()
+	}
+	if !errors.Is(numbers.TryForEach(func(s string) {}), collections.ErrTypeNotCompatible) {
+		t.Fail()
+	}
+	err = recoverError(func() {
+		numbers.Where(func(i int) bool { return 1/(i-i) > 0 })
+	})
+	if !errors.As(err, &panicked) || panicked.Function != reflect.TypeOf(func(int) bool { return false }) || panicked.Operator != "List.Where" {
+		t.Fail()
+	}
+	err = recoverError(func() {
+		collections.From([]int64{1}).List().Select(func(i int64) int64 { panic(boom) })
+	})
+	if !errors.As(err, &panicked) || panicked.Operator != "List.Select" {
+		t.Fail()
+	}
+	err = recoverError(func() {
+		collections.From(map[string]int{"a": 1}).Dictionary().Select(func(k string, v int) int { panic(boom) })
+	})
+	if !errors.As(err, &panicked) || panicked.Operator != "Dictionary.Select" {
+		t.Fail()
+	}
+	var mismatched *collections.TypeNotCompatible
+	if err = numbers.TryForEach(nil); !errors.As(err, &mismatched) || mismatched.Operator != "List.TryForEach" {
+		t.Fail()
+	}
+	if err = recoverError(func() { numbers.Select(nil) }); !errors.Is(err, collections.ErrTypeNotCompatible) {
+		t.Fail()
+	}
+	err = collections.From(map[string]int{"a": 1}).Dictionary().TryForEach(func(k string, v int) error { return boom })
+	if !errors.As(err, &failed) || failed.Position != "a" || failed.Operator != "Dictionary.TryForEach" {
+		t.Fail()
+	}
+}
+
+func TestErrorOperators(t *testing.T) {
+	var operators = map[string]func(){
+		"List.Sort":                func() { collections.From([]int{2, 1}).List().Sort(func(a, b string) bool { return a < b }) },
+		"List.Max":                 func() { collections.From([]int{2, 1}).List().Max(func(a, b string) bool { return a < b }) },
+		"SortedDictionary":         func() { collections.From(map[int]int{}).SortedDictionary(func(a, b string) bool { return a < b }) },
+		"Graph.ShortestPath":       func() { collections.From(map[int][]int{1: {2}}).Graph().ShortestPath(1, 2, func(a, b int) string { return "" }) },
+		"Cache.OnEvict":            func() { collections.From(map[int]string{}).Cache(1, collections.CacheOptions{OnEvict: func(string) {}}) },
+		"RegisterHasher":           func() { collections.RegisterHasher(func(int) int { return 0 }) },
+		"RegisterEqualityComparer": func() { collections.RegisterEqualityComparer(func(a, b int) int { return 0 }) },
+		"RegisterOrderComparer":    func() { collections.RegisterOrderComparer(func(a, b int) bool { return false }) },
+	}
+	for operator, function := range operators {
+		var mismatched *collections.TypeNotCompatible
+		if err := recoverError(function); !errors.As(err, &mismatched) || mismatched.Operator != operator {
+			t.Errorf("%s: %v", operator, err)
+		}
+	}
+	var panicked *collections.LambdaPanicked
+	var err = recoverError(func() {
+		collections.From([]int{2, 1}).List().OrderBy(func(a, b int) bool { panic("boom") })
+	})
+	if !errors.As(err, &panicked) || panicked.Operator != "List.OrderBy" {
+		t.Fail()
+	}
+}
@@ -0,0 +1,237 @@
+package collections
+
+import "reflect"
+
+//fast.go 为常见基础类型切片与具体函数签名提供不经反射调用的快速路径，行为与反射路径一致。
+//列表为命名切片类型或函数签名不在其中时返回 false，由调用方继续使用反射路径。
+
+//isNilFunc 判断是否为有类型的空函数，空函数交由反射路径抛出 TypeNotCompatible
+func isNilFunc(f interface{}) bool {
+	var value = reflect.ValueOf(f)
+	return value.Kind() == reflect.Func && value.IsNil()
+}
+
+//fastSelect Select 的快速路径，支持 func(T) R 与 func(int, T) R（T、R 为 int、float64、string）
+func fastSelect(slice, f interface{}) (interface{}, bool) {
+	if isNilFunc(f) {
+		return nil, false
+	}
+	defer guard("List.Select", reflect.TypeOf(f))
+	switch s := slice.(type) {
+	case []int:
+		switch function := f.(type) {
+		case func(int) int:
+			var result = make([]int, len(s))
+			for i, v := range s {
+				result[i] = function(v)
+			}
+			return result, true
+		case func(int, int) int:
+			var result = make([]int, len(s))
+			for i, v := range s {
+				result[i] = function(i, v)
+			}
+			return result, true
+		case func(int) float64:
+			var result = make([]float64, len(s))
+			for i, v := range s {
+				result[i] = function(v)
+			}
+			return result, true
+		case func(int) string:
+			var result = make([]string, len(s))
+			for i, v := range s {
+				result[i] = function(v)
+			}
+			return result, true
+		}
+	case []float64:
+		switch function := f.(type) {
+		case func(float64) float64:
+			var result = make([]float64, len(s))
+			for i, v := range s {
+				result[i] = function(v)
+			}
+			return result, true
+		case func(int, float64) float64:
+			var result = make([]float64, len(s))
+			for i, v := range s {
+				result[i] = function(i, v)
+			}
+			return result, true
+		case func(float64) int:
+			var result = make([]int, len(s))
+			for i, v := range s {
+				result[i] = function(v)
+			}
+			return result, true
+		case func(float64) string:
+			var result = make([]string, len(s))
+			for i, v := range s {
+				result[i] = function(v)
+			}
+			return result, true
+		}
+	case []string:
+		switch function := f.(type) {
+		case func(string) string:
+			var result = make([]string, len(s))
+			for i, v := range s {
+				result[i] = function(v)
+			}
+			return result, true
+		case func(int, string) string:
+			var result = make([]string, len(s))
+			for i, v := range s {
+				result[i] = function(i, v)
+			}
+			return result, true
+		case func(string) int:
+			var result = make([]int, len(s))
+			for i, v := range s {
+				result[i] = function(v)
+			}
+			return result, true
+		case func(string) float64:
+			var result = make([]float64, len(s))
+			for i, v := range s {
+				result[i] = function(v)
+			}
+			return result, true
+		}
+	}
+	return nil, false
+}
+
+//fastWhere Where 的快速路径，支持 func(T) bool（T 为 int、float64、string）
+func fastWhere(slice, f interface{}) (interface{}, bool) {
+	if isNilFunc(f) {
+		return nil, false
+	}
+	defer guard("List.Where", reflect.TypeOf(f))
+	switch s := slice.(type) {
+	case []int:
+		if function, ok := f.(func(int) bool); ok {
+			var result []int
+			for _, v := range s {
+				if function(v) {
+					result = append(result, v)
+				}
+			}
+			return result, true
+		}
+	case []float64:
+		if function, ok := f.(func(float64) bool); ok {
+			var result []float64
+			for _, v := range s {
+				if function(v) {
+					result = append(result, v)
+				}
+			}
+			return result, true
+		}
+	case []string:
+		if function, ok := f.(func(string) bool); ok {
+			var result []string
+			for _, v := range s {
+				if function(v) {
+					result = append(result, v)
+				}
+			}
+			return result, true
+		}
+	}
+	return nil, false
+}
+
+//fastForEach ForEach 的快速路径，支持 func(T)、func(int, T) 与返回 bool 的 func(T) bool、func(int, T) bool（T 为 int、float64、string）
+func fastForEach(operator string, slice, f interface{}) bool {
+	if isNilFunc(f) {
+		return false
+	}
+	defer guard(operator, reflect.TypeOf(f))
+	switch s := slice.(type) {
+	case []int:
+		switch function := f.(type) {
+		case func(int):
+			for _, v := range s {
+				function(v)
+			}
+			return true
+		case func(int, int):
+			for i, v := range s {
+				function(i, v)
+			}
+			return true
+		case func(int) bool:
+			for _, v := range s {
+				if !function(v) {
+					break
+				}
+			}
+			return true
+		case func(int, int) bool:
+			for i, v := range s {
+				if !function(i, v) {
+					break
+				}
+			}
+			return true
+		}
+	case []float64:
+		switch function := f.(type) {
+		case func(float64):
+			for _, v := range s {
+				function(v)
+			}
+			return true
+		case func(int, float64):
+			for i, v := range s {
+				function(i, v)
+			}
+			return true
+		case func(float64) bool:
+			for _, v := range s {
+				if !function(v) {
+					break
+				}
+			}
+			return true
+		case func(int, float64) bool:
+			for i, v := range s {
+				if !function(i, v) {
+					break
+				}
+			}
+			return true
+		}
+	case []string:
+		switch function := f.(type) {
+		case func(string):
+			for _, v := range s {
+				function(v)
+			}
+			return true
+		case func(int, string):
+			for i, v := range s {
+				function(i, v)
+			}
+			return true
+		case func(string) bool:
+			for _, v := range s {
+				if !function(v) {
+					break
+				}
+			}
+			return true
+		case func(int, string) bool:
+			for i, v := range s {
+				if !function(i, v) {
+					break
+				}
+			}
+			return true
+		}
+	}
+	return false
+}
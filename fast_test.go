@@ -0,0 +1,93 @@
+package collections_test
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/johnwiichang/collections"
+)
+
+type (
+	slowInts    []int
+	slowFloats  []float64
+	slowStrings []string
+)
+
+//paths 返回同一组数据的快速路径列表与反射路径列表（命名切片类型不会进入快速路径）
+func paths(slice interface{}) (fast, slow collections.List) {
+	var value = reflect.ValueOf(slice)
+	var named = map[reflect.Type]reflect.Type{
+		reflect.TypeOf([]int{}):     reflect.TypeOf(slowInts{}),
+		reflect.TypeOf([]float64{}): reflect.TypeOf(slowFloats{}),
+		reflect.TypeOf([]string{}):  reflect.TypeOf(slowStrings{}),
+	}[value.Type()]
+	return collections.From(slice).List(), collections.From(value.Convert(named).Interface()).List()
+}
+
+//sameElements 比较两个列表的元素（忽略命名切片类型的差异）
+func sameElements(a, b collections.List) bool {
+	var x, y = reflect.ValueOf(a.Slice()), reflect.ValueOf(b.Slice())
+	return x.Type().Elem() == y.Type().Elem() && reflect.DeepEqual(x.Interface(), y.Convert(x.Type()).Interface())
+}
+
+func TestFastPaths(t *testing.T) {
+	var data = []interface{}{[]int{3, 1, 4, 1, 5}, []float64{2.5, -1, 0}, []string{"a", "bb", ""}, []int{}}
+	var selectors = []interface{}{
+		func(x int) int { return x * 2 }, func(i, x int) int { return i + x }, func(x int) float64 { return float64(x) / 2 }, func(x int) string { return strconv.Itoa(x) },
+		func(x float64) float64 { return x * x }, func(i int, x float64) float64 { return x - float64(i) }, func(x float64) int { return int(x) }, func(x float64) string { return strconv.FormatFloat(x, 'f', 1, 64) },
+		func(s string) string { return strings.ToUpper(s) }, func(i int, s string) string { return s + strconv.Itoa(i) }, func(s string) int { return len(s) }, func(s string) float64 { return float64(len(s)) },
+	}
+	var predicates = []interface{}{func(x int) bool { return x > 1 }, func(x float64) bool { return x >= 0 }, func(s string) bool { return s != "" }}
+	for _, slice := range data {
+		var fast, slow = paths(slice)
+		var elem = fast.Type().Elem()
+		for _, f := range selectors {
+			if ft := reflect.TypeOf(f); ft.In(ft.NumIn()-1) == elem && !sameElements(fast.Select(f), slow.Select(f)) {
+				t.Errorf("Select %s on %v", ft, slice)
+			}
+		}
+		for _, f := range predicates {
+			if reflect.TypeOf(f).In(0) == elem && !sameElements(fast.Where(f), slow.Where(f)) {
+				t.Errorf("Where %T on %v", f, slice)
+			}
+		}
+		var nils = map[reflect.Kind][]interface{}{
+			reflect.Int:     {(func(int) bool)(nil), (func(int) int)(nil), (func(int))(nil)},
+			reflect.Float64: {(func(float64) bool)(nil), (func(float64) float64)(nil), (func(float64))(nil)},
+			reflect.String:  {(func(string) bool)(nil), (func(string) string)(nil), (func(string))(nil)},
+		}[elem.Kind()]
+		for _, l := range []collections.List{fast, slow} {
+			var where, sel, each = nils[0], nils[1], nils[2]
+			if !errors.Is(recoverError(func() { l.Where(where) }), collections.ErrTypeNotCompatible) ||
+				!errors.Is(recoverError(func() { l.Select(sel) }), collections.ErrTypeNotCompatible) ||
+				!errors.Is(l.TryForEach(each), collections.ErrTypeNotCompatible) {
+				t.Errorf("nil lambda on %T", l.Slice())
+			}
+		}
+		var visits [2][]string
+		for path, l := range []collections.List{fast, slow} {
+			var record = func(i int, v interface{}) { visits[path] = append(visits[path], fmt.Sprint(i, ":", v)) }
+			switch elem.Kind() {
+			case reflect.Int:
+				l.ForEach(func(i, x int) bool { record(i, x); return i < 2 }).ForEach(func(x int) { record(-1, x) })
+			case reflect.String:
+				l.ForEach(func(i int, s string) { record(i, s) }).ForEach(func(s string) bool { record(-1, s); return s == "a" })
+			}
+		}
+		if !reflect.DeepEqual(visits[0], visits[1]) {
+			t.Errorf("ForEach on %v: %v != %v", slice, visits[0], visits[1])
+		}
+	}
+	var boom = errors.New("boom")
+	for _, l := range []collections.List{collections.From([]int{1}).List(), collections.From(slowInts{1}).List()} {
+		var err = l.TryForEach(func(x int) { panic(boom) })
+		var panicked *collections.LambdaPanicked
+		if !errors.As(err, &panicked) || !errors.Is(err, boom) || panicked.Function != reflect.TypeOf(func(int) {}) {
+			t.Fail()
+		}
+	}
+}
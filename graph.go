@@ -0,0 +1,315 @@
+package collections
+
+import (
+	"container/heap"
+	"math"
+	"reflect"
+	"sort"
+)
+
+type (
+	Graph interface {
+		AddEdge(from, to interface{}) Graph
+		Nodes() List
+		Neighbors(node interface{}) List
+		BFS(start interface{}) List
+		DFS(start interface{}) List
+		TopologicalSort() (List, error)
+		ShortestPath(from, to interface{}, weight ...interface{}) (List, bool)
+		ConnectedComponents() List
+
+		Type() reflect.Type
+	}
+
+	//graph 有向图，顶点按加入顺序编号，边按加入顺序保存
+	graph struct {
+		t       reflect.Type
+		nodes   []reflect.Value
+		indexes map[interface{}]int
+		edges   [][]int
+	}
+
+	//distance 最小堆中的顶点及其入堆时的距离（入堆后不再修改）
+	distance struct {
+		node int
+		dist float64
+	}
+
+	//distances Dijkstra 算法使用的最小堆
+	distances []distance
+)
+
+func newGraph(kt reflect.Type) *graph {
+	return &graph{t: reflect.MapOf(kt, reflect.SliceOf(kt)), indexes: make(map[interface{}]int)}
+}
+
+//toGraph 从邻接表映射构造有向图（键为有序类型时按键的顺序加入顶点）
+func toGraph(value reflect.Value) *graph {
+	var t = value.Type()
+	if err := typeRequired(t.Elem(), reflect.SliceOf(t.Key())); err != nil {
+		panic(err)
+	}
+	var g, keys = newGraph(t.Key()), value.MapKeys()
+	if less := orderedLess(t.Key()); less != nil {
+		sort.Slice(keys, func(i, j int) bool { return less(keys[i], keys[j]) })
+	}
+	for _, key := range keys {
+		var from, targets = g.node(key), value.MapIndex(key)
+		for i := 0; i < targets.Len(); i++ {
+			g.edges[from] = append(g.edges[from], g.node(targets.Index(i).Convert(t.Key())))
+		}
+	}
+	return g
+}
+
+func (d distances) Len() int            { return len(d) }
+func (d distances) Less(i, j int) bool  { return d[i].dist < d[j].dist }
+func (d distances) Swap(i, j int)       { d[i], d[j] = d[j], d[i] }
+func (d *distances) Push(x interface{}) { *d = append(*d, x.(distance)) }
+func (d *distances) Pop() interface{} {
+	var last = (*d)[len(*d)-1]
+	*d = (*d)[:len(*d)-1]
+	return last
+}
+
+//node 获取顶点编号，不存在时加入顶点
+func (g *graph) node(value reflect.Value) int {
+	var key = value.Interface()
+	if index, existed := g.indexes[key]; existed {
+		return index
+	}
+	g.indexes[key] = len(g.nodes)
+	g.nodes, g.edges = append(g.nodes, value), append(g.edges, nil)
+	return len(g.nodes) - 1
+}
+
+//index 获取已存在顶点的编号
+func (g *graph) index(node interface{}) (int, bool) {
+	var index, existed = g.indexes[convertTo(node, g.t.Key()).Interface()]
+	return index, existed
+}
+
+//list 将顶点编号转换为 List 集合
+func (g *graph) list(indexes []int) *list {
+	var newlist = newList(g.t.Elem(), len(indexes))
+	for i, index := range indexes {
+		newlist.value.Index(i).Set(g.nodes[index])
+	}
+	return newlist
+}
+
+func (g *graph) Type() reflect.Type {
+	return g.t
+}
+
+func (g *graph) AddEdge(from, to interface{}) Graph {
+	var source = g.node(convertTo(from, g.t.Key()))
+	g.edges[source] = append(g.edges[source], g.node(convertTo(to, g.t.Key())))
+	return g
+}
+
+//Nodes 按加入顺序获取全部顶点
+func (g *graph) Nodes() List {
+	var newlist = newList(g.t.Elem(), len(g.nodes))
+	for i, node := range g.nodes {
+		newlist.value.Index(i).Set(node)
+	}
+	return newlist
+}
+
+//Neighbors 获取顶点的后继（顶点不存在时为空列表）
+func (g *graph) Neighbors(node interface{}) List {
+	var index, existed = g.index(node)
+	if !existed {
+		return g.list(nil)
+	}
+	return g.list(g.edges[index])
+}
+
+//BFS 从起点开始广度优先遍历可达顶点（起点不存在时为空列表）
+func (g *graph) BFS(start interface{}) List {
+	var index, existed = g.index(start)
+	if !existed {
+		return g.list(nil)
+	}
+	var visited, order = make([]bool, len(g.nodes)), []int{index}
+	visited[index] = true
+	for i := 0; i < len(order); i++ {
+		for _, next := range g.edges[order[i]] {
+			if !visited[next] {
+				visited[next] = true
+				order = append(order, next)
+			}
+		}
+	}
+	return g.list(order)
+}
+
+//DFS 从起点开始深度优先（先序）遍历可达顶点（起点不存在时为空列表）
+func (g *graph) DFS(start interface{}) List {
+	var index, existed = g.index(start)
+	if !existed {
+		return g.list(nil)
+	}
+	var visited, order = make([]bool, len(g.nodes)), []int{}
+	var visit func(int)
+	visit = func(node int) {
+		visited[node] = true
+		order = append(order, node)
+		for _, next := range g.edges[node] {
+			if !visited[next] {
+				visit(next)
+			}
+		}
+	}
+	visit(index)
+	return g.list(order)
+}
+
+//TopologicalSort 拓扑排序
+//如果存在环，那么会返回 CycleDetected 错误（包含环上的顶点）。
+func (g *graph) TopologicalSort() (List, error) {
+	var indegrees, order = make([]int, len(g.nodes)), []int{}
+	for _, targets := range g.edges {
+		for _, target := range targets {
+			indegrees[target]++
+		}
+	}
+	for node, indegree := range indegrees {
+		if indegree == 0 {
+			order = append(order, node)
+		}
+	}
+	for i := 0; i < len(order); i++ {
+		for _, next := range g.edges[order[i]] {
+			if indegrees[next]--; indegrees[next] == 0 {
+				order = append(order, next)
+			}
+		}
+	}
+	if len(order) < len(g.nodes) {
+		return nil, throwCycleDetected(g.cycle(indegrees).Slice())
+	}
+	return g.list(order), nil
+}
+
+//cycle 在剩余入度不为零的顶点中查找一个环
+func (g *graph) cycle(indegrees []int) *list {
+	var states, stack = make([]int, len(g.nodes)), []int{}
+	var found []int
+	var visit func(int) bool
+	visit = func(node int) bool {
+		states[node], stack = 1, append(stack, node)
+		for _, next := range g.edges[node] {
+			if indegrees[next] == 0 {
+				continue
+			}
+			if states[next] == 1 {
+				for i := len(stack) - 1; i >= 0; i-- {
+					if stack[i] == next {
+						found = append(append(found, stack[i:]...), next)
+						return true
+					}
+				}
+			}
+			if states[next] == 0 && visit(next) {
+				return true
+			}
+		}
+		states[node], stack = 2, stack[:len(stack)-1]
+		return false
+	}
+	for node, indegree := range indegrees {
+		if indegree > 0 && states[node] == 0 && visit(node) {
+			break
+		}
+	}
+	return g.list(found)
+}
+
+//ShortestPath 获取两点间的最短路径（包含起点与终点），不可达时返回 false
+//不给出权重时按边数计算，否则使用 func(from, to K) W 计算数值权重（Dijkstra 算法），W 可以为任意整数或浮点类型，
+//权重为负数或 NaN 时抛出 ArgumentIsInvalid 异常。
+func (g *graph) ShortestPath(from, to interface{}, weight ...interface{}) (List, bool) {
+	var source, sourceExisted = g.index(from)
+	var target, targetExisted = g.index(to)
+	if !sourceExisted || !targetExisted {
+		return nil, false
+	}
+	var cost = func(int, int) float64 { return 1 }
+	if len(weight) > 0 {
+		var kt = g.t.Key()
+		var function = lambda("Graph.ShortestPath", weight[0], newFunc(kt, kt)(types.AnyType)())
+		if !isNumber(function.Type().Out(0)) {
+			var err = throwTypeNotCompatiable("func(K, K) <number>", function.Type())
+			err.(*TypeNotCompatible).Operator = "Graph.ShortestPath"
+			panic(err)
+		}
+		cost = func(a, b int) float64 {
+			var w = function.call(g.nodes[a], g.nodes[b])[0].Convert(reflect.TypeOf(0.0)).Float()
+			if w < 0 || math.IsNaN(w) {
+				panic(throwArgumentIsInvalid("weight", w))
+			}
+			return w
+		}
+	}
+	var d, dist, previous = &distances{}, make([]float64, len(g.nodes)), make([]int, len(g.nodes))
+	for i := range dist {
+		dist[i], previous[i] = math.Inf(1), -1
+	}
+	dist[source] = 0
+	heap.Push(d, distance{source, 0})
+	for d.Len() > 0 {
+		var entry = heap.Pop(d).(distance)
+		if entry.dist > dist[entry.node] {
+			//顶点入堆后找到了更短的距离，跳过过期的项
+			continue
+		} else if entry.node == target {
+			break
+		}
+		for _, next := range g.edges[entry.node] {
+			if through := entry.dist + cost(entry.node, next); through < dist[next] {
+				dist[next], previous[next] = through, entry.node
+				heap.Push(d, distance{next, through})
+			}
+		}
+	}
+	if math.IsInf(dist[target], 1) {
+		return nil, false
+	}
+	var path []int
+	for node := target; node >= 0; node = previous[node] {
+		path = append([]int{node}, path...)
+	}
+	return g.list(path), true
+}
+
+//ConnectedComponents 获取（弱）连通分量，每个分量为顶点切片
+func (g *graph) ConnectedComponents() List {
+	var undirected = make([][]int, len(g.nodes))
+	for node, targets := range g.edges {
+		for _, target := range targets {
+			undirected[node] = append(undirected[node], target)
+			undirected[target] = append(undirected[target], node)
+		}
+	}
+	var visited = make([]bool, len(g.nodes))
+	var components = newList(reflect.SliceOf(g.t.Elem()))
+	for start := range g.nodes {
+		if visited[start] {
+			continue
+		}
+		var component = []int{start}
+		visited[start] = true
+		for i := 0; i < len(component); i++ {
+			for _, next := range undirected[component[i]] {
+				if !visited[next] {
+					visited[next] = true
+					component = append(component, next)
+				}
+			}
+		}
+		components.value.Set(reflect.Append(*components.value, *g.list(component).value))
+	}
+	return components
+}
@@ -0,0 +1,139 @@
+package collections_test
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/johnwiichang/collections"
+)
+
+var jobs = map[string][]string{
+	"build":   {"compile", "assets"},
+	"compile": {"fetch"},
+	"assets":  {"fetch"},
+	"deploy":  {"build"},
+	"fetch":   {},
+	"lint":    {},
+}
+
+func TestGraphTraversal(t *testing.T) {
+	var graph = collections.From(jobs).Graph()
+	if !reflect.DeepEqual(graph.BFS("deploy").Slice(), []string{"deploy", "build", "compile", "assets", "fetch"}) {
+		t.Fail()
+	}
+	if !reflect.DeepEqual(graph.DFS("build").Slice(), []string{"build", "compile", "fetch", "assets"}) {
+		t.Fail()
+	}
+	if graph.BFS("unknown").Count() != 0 || graph.Neighbors("build").Count() != 2 {
+		t.Fail()
+	}
+	var components = graph.ConnectedComponents().Select(func(c []string) int { return len(c) }).Slice()
+	if !reflect.DeepEqual(components, []int{5, 1}) {
+		t.Fail()
+	}
+}
+
+func TestGraphTopologicalSort(t *testing.T) {
+	var order, err = collections.From(jobs).Graph().TopologicalSort()
+	if err != nil || order.First("deploy") > order.First("build") || order.First("compile") > order.First("fetch") {
+		t.Fail()
+	}
+	var graph = collections.From(jobs).Graph().AddEdge("fetch", "deploy")
+	var cycle *collections.CycleDetected
+	if _, err = graph.TopologicalSort(); !errors.As(err, &cycle) {
+		t.FailNow()
+	}
+	var nodes = cycle.Cycle.([]string)
+	if len(nodes) < 2 || nodes[0] != nodes[len(nodes)-1] {
+		t.Fail()
+	}
+}
+
+func TestGraphShortestPath(t *testing.T) {
+	type edge struct {
+		From, To int
+		Weight   float64
+	}
+	var edges = []edge{{1, 2, 7}, {1, 3, 9}, {1, 6, 14}, {2, 3, 10}, {2, 4, 15}, {3, 4, 11}, {3, 6, 2}, {4, 5, 6}, {6, 5, 9}}
+	var weights = map[[2]int]float64{}
+	for _, e := range edges {
+		weights[[2]int{e.From, e.To}] = e.Weight
+	}
+	var graph = collections.From(edges).List().ToGraph(func(e edge) (int, int) { return e.From, e.To })
+	if path, _ := graph.ShortestPath(1, 5); !reflect.DeepEqual(path.Slice(), []int{1, 6, 5}) {
+		t.Fail()
+	}
+	if path, _ := graph.ShortestPath(1, 5, func(a, b int) float64 { return weights[[2]int{a, b}] }); !reflect.DeepEqual(path.Slice(), []int{1, 3, 6, 5}) {
+		t.Fail()
+	}
+	if path, _ := graph.ShortestPath(1, 5, func(a, b int) uint8 { return uint8(weights[[2]int{a, b}]) }); !reflect.DeepEqual(path.Slice(), []int{1, 3, 6, 5}) {
+		t.Fail()
+	}
+	if _, ok := graph.ShortestPath(5, 1); ok {
+		t.Fail()
+	}
+	var err = recoverError(func() {
+		graph.ShortestPath(1, 5, func(a, b int) int { return a - b })
+	})
+	if !errors.Is(err, collections.ErrArgumentIsInvalid) {
+		t.Fail()
+	}
+	EstimateFail(t, func(*testing.T) {
+		graph.ShortestPath(1, 5, func(a, b int) string { return "" })
+	})
+}
+
+func TestGraphShortestPathRandomized(t *testing.T) {
+	const nodes, infinity = 8, 1 << 30
+	var random = rand.New(rand.NewSource(37))
+	for round := 0; round < 200; round++ {
+		var adjacency, weights = map[int][]int{}, map[[2]int]int{}
+		var oracle [nodes][nodes]int
+		for i := range oracle {
+			for j := range oracle[i] {
+				if oracle[i][j] = infinity; i == j {
+					oracle[i][j] = 0
+				}
+			}
+			adjacency[i] = nil
+		}
+		for e := random.Intn(nodes * 3); e > 0; e-- {
+			var from, to, weight = random.Intn(nodes), random.Intn(nodes), random.Intn(10)
+			if _, existed := weights[[2]int{from, to}]; existed || from == to {
+				continue
+			}
+			adjacency[from] = append(adjacency[from], to)
+			weights[[2]int{from, to}], oracle[from][to] = weight, weight
+		}
+		//Floyd–Warshall 作为对照
+		for k := 0; k < nodes; k++ {
+			for i := 0; i < nodes; i++ {
+				for j := 0; j < nodes; j++ {
+					if oracle[i][k]+oracle[k][j] < oracle[i][j] {
+						oracle[i][j] = oracle[i][k] + oracle[k][j]
+					}
+				}
+			}
+		}
+		var graph = collections.From(adjacency).Graph()
+		for from := 0; from < nodes; from++ {
+			for to := 0; to < nodes; to++ {
+				var path, ok = graph.ShortestPath(from, to, func(a, b int) int { return weights[[2]int{a, b}] })
+				if ok != (oracle[from][to] < infinity) {
+					t.Fatalf("round %d: %d -> %d reachable %v", round, from, to, ok)
+				} else if !ok {
+					continue
+				}
+				var steps, cost = path.Slice().([]int), 0
+				for i := 1; i < len(steps); i++ {
+					cost += weights[[2]int{steps[i-1], steps[i]}]
+				}
+				if steps[0] != from || steps[len(steps)-1] != to || cost != oracle[from][to] {
+					t.Fatalf("round %d: %d -> %d path %v costs %d, want %d", round, from, to, steps, cost, oracle[from][to])
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,299 @@
+package collections
+
+import (
+	"hash/fnv"
+	"math"
+	"math/bits"
+	"reflect"
+)
+
+const (
+	hamtBits = 5
+	hamtMask = 1<<hamtBits - 1
+)
+
+type (
+	ImmutableDictionary interface {
+		Get(key interface{}) (interface{}, bool)
+		Set(key, value interface{}) ImmutableDictionary
+		Remove(key interface{}) ImmutableDictionary
+		Contains(key interface{}) bool
+		Len() int
+		Keys() List
+		Dictionary() Dictionary
+
+		Type() reflect.Type
+	}
+
+	//hamtEntry 节点条目，child 不为空时为分支，否则为键值对
+	hamtEntry struct {
+		hash       uint64
+		key, value reflect.Value
+		child      *hamtNode
+	}
+
+	//hamtNode 哈希数组映射前缀树节点，超出哈希位数后退化为线性保存冲突条目
+	hamtNode struct {
+		bitmap  uint32
+		entries []*hamtEntry
+	}
+
+	//immutableDictionary 基于 HAMT 的持久化映射集合，修改时仅拷贝根到叶子的路径
+	immutableDictionary struct {
+		t    reflect.Type
+		root *hamtNode
+		size int
+	}
+)
+
+func newImmutableDictionary(t reflect.Type) *immutableDictionary {
+	return &immutableDictionary{t: t, root: &hamtNode{}}
+}
+
+//hashValue 计算可比较值的哈希（相等的值哈希相同），优先使用注册的哈希函数
+//类型有 EqualsTo* 钩子或注册的相等比较函数而没有哈希函数时，相等的值可能有不同的原始值，统一返回 0（由 keyEquals 逐个比较）。
+func hashValue(v reflect.Value) uint64 {
+	var t = v.Type()
+	if function := getHasher(t); function != nil {
+		return call(*function, v)[0].Uint()
+	} else if getCompareHook(t, t) != nil || getEqualityComparer(t, t) != nil {
+		return 0
+	}
+	var h = fnv.New64a()
+	var write = func(n uint64) {
+		var buffer [8]byte
+		for i := range buffer {
+			buffer[i] = byte(n >> (8 * i))
+		}
+		h.Write(buffer[:])
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			write(1)
+		} else {
+			write(0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		write(uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		write(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		//+0 与 -0 相等，需要得到相同的哈希
+		if f := v.Float(); f != 0 {
+			write(math.Float64bits(f))
+		} else {
+			write(0)
+		}
+	case reflect.Complex64, reflect.Complex128:
+		var c = v.Complex()
+		write(hashValue(reflect.ValueOf(real(c))))
+		write(hashValue(reflect.ValueOf(imag(c))))
+	case reflect.String:
+		h.Write([]byte(v.String()))
+	case reflect.Ptr, reflect.Chan, reflect.UnsafePointer:
+		write(uint64(v.Pointer()))
+	case reflect.Interface:
+		if !v.IsNil() {
+			write(hashValue(v.Elem()))
+		}
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			write(hashValue(v.Index(i)))
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			write(hashValue(v.Field(i)))
+		}
+	}
+	return h.Sum64()
+}
+
+func keyEquals(a, b reflect.Value) bool {
+	return valueCompare(a, b)
+}
+
+func (node *hamtNode) copy() *hamtNode {
+	return &hamtNode{bitmap: node.bitmap, entries: append([]*hamtEntry{}, node.entries...)}
+}
+
+//position 获取哈希在当前层的位与条目位置
+func (node *hamtNode) position(hash uint64, shift uint) (uint32, int) {
+	var bit = uint32(1) << ((hash >> shift) & hamtMask)
+	return bit, bits.OnesCount32(node.bitmap & (bit - 1))
+}
+
+func (node *hamtNode) get(hash uint64, shift uint, key reflect.Value) *hamtEntry {
+	if shift >= 64 {
+		for _, entry := range node.entries {
+			if keyEquals(entry.key, key) {
+				return entry
+			}
+		}
+		return nil
+	}
+	var bit, index = node.position(hash, shift)
+	if node.bitmap&bit == 0 {
+		return nil
+	}
+	var entry = node.entries[index]
+	if entry.child != nil {
+		return entry.child.get(hash, shift+hamtBits, key)
+	} else if keyEquals(entry.key, key) {
+		return entry
+	}
+	return nil
+}
+
+func (node *hamtNode) set(leaf *hamtEntry, shift uint) (*hamtNode, bool) {
+	if shift >= 64 {
+		var newnode = node.copy()
+		for index, entry := range newnode.entries {
+			if keyEquals(entry.key, leaf.key) {
+				newnode.entries[index] = leaf
+				return newnode, false
+			}
+		}
+		newnode.entries = append(newnode.entries, leaf)
+		return newnode, true
+	}
+	var bit, index = node.position(leaf.hash, shift)
+	var newnode = node.copy()
+	if node.bitmap&bit == 0 {
+		newnode.bitmap |= bit
+		newnode.entries = append(newnode.entries[:index], append([]*hamtEntry{leaf}, newnode.entries[index:]...)...)
+		return newnode, true
+	}
+	var entry, added = node.entries[index], false
+	if entry.child != nil {
+		var child *hamtNode
+		child, added = entry.child.set(leaf, shift+hamtBits)
+		newnode.entries[index] = &hamtEntry{child: child}
+	} else if keyEquals(entry.key, leaf.key) {
+		newnode.entries[index] = leaf
+	} else {
+		var child, _ = (&hamtNode{}).set(entry, shift+hamtBits)
+		child, added = child.set(leaf, shift+hamtBits)
+		newnode.entries[index] = &hamtEntry{child: child}
+	}
+	return newnode, added
+}
+
+func (node *hamtNode) remove(hash uint64, shift uint, key reflect.Value) (*hamtNode, bool) {
+	if shift >= 64 {
+		for index, entry := range node.entries {
+			if keyEquals(entry.key, key) {
+				var newnode = node.copy()
+				newnode.entries = append(newnode.entries[:index], newnode.entries[index+1:]...)
+				return newnode, true
+			}
+		}
+		return node, false
+	}
+	var bit, index = node.position(hash, shift)
+	if node.bitmap&bit == 0 {
+		return node, false
+	}
+	var entry, newnode = node.entries[index], node.copy()
+	if entry.child != nil {
+		var child, removed = entry.child.remove(hash, shift+hamtBits, key)
+		if !removed {
+			return node, false
+		}
+		switch {
+		case len(child.entries) == 0:
+		case len(child.entries) == 1 && child.entries[0].child == nil:
+			//仅剩一个键值对时上移，保持树的紧凑
+			newnode.entries[index] = child.entries[0]
+			return newnode, true
+		default:
+			newnode.entries[index] = &hamtEntry{child: child}
+			return newnode, true
+		}
+	} else if !keyEquals(entry.key, key) {
+		return node, false
+	}
+	newnode.bitmap &^= bit
+	newnode.entries = append(newnode.entries[:index], newnode.entries[index+1:]...)
+	return newnode, true
+}
+
+func (node *hamtNode) each(f func(entry *hamtEntry)) {
+	for _, entry := range node.entries {
+		if entry.child != nil {
+			entry.child.each(f)
+		} else {
+			f(entry)
+		}
+	}
+}
+
+func toImmutableDictionary(d Dictionary) *immutableDictionary {
+	var id, t = newImmutableDictionary(d.Type()), d.Type()
+	d.ForEach(func(k, v interface{}) {
+		id = id.set(convertTo(k, t.Key()), convertTo(v, t.Elem()))
+	})
+	return id
+}
+
+func (id *immutableDictionary) Type() reflect.Type {
+	return id.t
+}
+
+func (id *immutableDictionary) Len() int {
+	return id.size
+}
+
+func (id *immutableDictionary) Get(key interface{}) (interface{}, bool) {
+	var k = convertTo(key, id.t.Key())
+	if entry := id.root.get(hashValue(k), 0, k); entry != nil {
+		return entry.value.Interface(), true
+	}
+	return nil, false
+}
+
+func (id *immutableDictionary) Contains(key interface{}) bool {
+	var _, ok = id.Get(key)
+	return ok
+}
+
+func (id *immutableDictionary) set(key, value reflect.Value) *immutableDictionary {
+	var root, added = id.root.set(&hamtEntry{hash: hashValue(key), key: key, value: value}, 0)
+	var newmap = &immutableDictionary{t: id.t, root: root, size: id.size}
+	if added {
+		newmap.size++
+	}
+	return newmap
+}
+
+//Set 设置键值并返回新版本
+func (id *immutableDictionary) Set(key, value interface{}) ImmutableDictionary {
+	return id.set(convertTo(key, id.t.Key()), convertTo(value, id.t.Elem()))
+}
+
+//Remove 移除键并返回新版本（键不存在时返回当前版本）
+func (id *immutableDictionary) Remove(key interface{}) ImmutableDictionary {
+	var k = convertTo(key, id.t.Key())
+	var root, removed = id.root.remove(hashValue(k), 0, k)
+	if !removed {
+		return id
+	}
+	return &immutableDictionary{t: id.t, root: root, size: id.size - 1}
+}
+
+func (id *immutableDictionary) Keys() List {
+	var keys = newList(reflect.SliceOf(id.t.Key()))
+	id.root.each(func(entry *hamtEntry) {
+		keys.value.Set(reflect.Append(*keys.value, entry.key))
+	})
+	return keys
+}
+
+//Dictionary 拷贝为可变的 Dictionary 集合
+func (id *immutableDictionary) Dictionary() Dictionary {
+	var dict = newDictionary(id.t, id.size)
+	id.root.each(func(entry *hamtEntry) {
+		dict.value.SetMapIndex(entry.key, entry.value)
+	})
+	return dict
+}
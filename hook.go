@@ -7,18 +7,35 @@ import (
 
 var (
 	compareHooks = &sync.Map{}
+	orderHooks   = &sync.Map{}
+	lessHooks    = &sync.Map{}
 )
 
-func getCompareHook(caller, target reflect.Type) *reflect.Value {
-	storage, existed := compareHooks.Load(caller)
+//getCachedHook 获取并缓存 caller 类型上以 target 类型为参数的钩子方法
+func getCachedHook(hooks *sync.Map, name string, caller, target reflect.Type, out reflect.Type) *reflect.Value {
+	storage, existed := hooks.Load(caller)
 	if !existed {
-		storage = &sync.Map{}
-		compareHooks.Store(caller, storage)
+		storage, _ = hooks.LoadOrStore(caller, &sync.Map{})
 	}
 	function, existed := storage.(*sync.Map).Load(target)
 	if !existed {
-		function = getHook(caller, "EqualsTo*", newFunc(caller, target)(types.Bool)())
+		function = getHook(caller, name, newFunc(caller, target)(out)())
 		storage.(*sync.Map).Store(target, function)
 	}
 	return function.(*reflect.Value)
 }
+
+//getCompareHook 获取相等比较钩子 EqualsTo*(target) bool
+func getCompareHook(caller, target reflect.Type) *reflect.Value {
+	return getCachedHook(compareHooks, "EqualsTo*", caller, target, types.Bool)
+}
+
+//getOrderHook 获取排序比较钩子 CompareTo*(target) int（小于、等于、大于分别返回负数、零、正数）
+func getOrderHook(caller, target reflect.Type) *reflect.Value {
+	return getCachedHook(orderHooks, "CompareTo*", caller, target, types.Int)
+}
+
+//getLessHook 获取小于比较钩子 Less*(target) bool
+func getLessHook(caller, target reflect.Type) *reflect.Value {
+	return getCachedHook(lessHooks, "Less*", caller, target, types.Bool)
+}
@@ -0,0 +1,109 @@
+package collections_test
+
+import (
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/johnwiichang/collections"
+)
+
+func TestImmutableList(t *testing.T) {
+	var versions = []collections.ImmutableList{collections.From([]int{}).List().ToImmutable()}
+	for i := 0; i < 2000; i++ {
+		versions = append(versions, versions[i].Append(i))
+	}
+	for size, version := range versions {
+		if version.Len() != size || size > 0 && version.Get(size-1) != size-1 {
+			t.Fatalf("unexpected version %d", size)
+		}
+	}
+	var last = versions[len(versions)-1]
+	var changed = last.Set(1500, -1)
+	if last.Get(1500) != 1500 || changed.Get(1500) != -1 {
+		t.Fail()
+	}
+	var popped = changed
+	for popped.Len() > 1000 {
+		popped = popped.Pop()
+	}
+	if !reflect.DeepEqual(popped.List().Slice(), versions[1000].List().Slice()) || changed.Len() != 2000 {
+		t.Fail()
+	}
+	var sum int
+	for it := versions[100].Iterator(); it.Next(); {
+		sum += it.Value().(int)
+	}
+	if sum != 4950 || versions[100].List().Where(func(n int) bool { return n < 10 }).Count() != 10 {
+		t.Fail()
+	}
+	EstimateFail(t, func(*testing.T) {
+		last.Get(2000)
+	})
+	EstimateFail(t, func(*testing.T) {
+		versions[0].Pop()
+	})
+}
+
+func TestImmutableDictionary(t *testing.T) {
+	var empty = collections.From(map[int]int{}).Dictionary().ToImmutable()
+	var full = empty
+	for i := 0; i < 5000; i++ {
+		full = full.Set(i, i*i)
+	}
+	if empty.Len() != 0 || full.Len() != 5000 || full.Set(1, 1).Len() != 5000 {
+		t.Fail()
+	}
+	var removed = full
+	for i := 0; i < 5000; i += 2 {
+		removed = removed.Remove(i)
+	}
+	if removed.Len() != 2500 || removed.Contains(2) || !full.Contains(2) {
+		t.Fail()
+	}
+	if value, _ := removed.Get(99); value != 9801 {
+		t.Fail()
+	}
+	if removed.Remove(-1) != removed || removed.Dictionary().Count(func(k int) bool { return k%2 == 0 }) != 0 {
+		t.Fail()
+	}
+	if removed.Keys().Count() != 2500 {
+		t.Fail()
+	}
+	var keyed = dicts.NumberWithTrue.ToImmutable().Set(6, false)
+	if keyed.Len() != 6 || dicts.NumberWithTrue.Count() != 5 {
+		t.Fail()
+	}
+}
+
+//trimmed 去除首尾空白后相等的键
+type trimmed string
+
+func (s trimmed) EqualsTo(other trimmed) bool {
+	return strings.TrimSpace(string(s)) == strings.TrimSpace(string(other))
+}
+
+func TestImmutableDictionaryCustomEquality(t *testing.T) {
+	var dict = collections.From(map[trimmed]int{}).Dictionary().ToImmutable()
+	for i := 0; i < 8; i++ {
+		dict = dict.Set(trimmed(strconv.Itoa(i)), i)
+	}
+	for i := 0; i < 8; i++ {
+		if value, ok := dict.Get(trimmed(" " + strconv.Itoa(i) + " ")); !ok || value != i {
+			t.Fail()
+		}
+	}
+	if dict.Set(trimmed(" 1"), 10).Len() != 8 || dict.Remove(trimmed("2 ")).Len() != 7 {
+		t.Fail()
+	}
+	var rats = collections.From(map[*big.Rat]string{}).Dictionary().ToImmutable().Set(big.NewRat(1, 2), "half")
+	if value, ok := rats.Get(big.NewRat(2, 4)); !ok || value != "half" {
+		t.Fail()
+	}
+	var floats = collections.From(map[*big.Float]string{}).Dictionary().ToImmutable().Set(big.NewFloat(0.5), "half")
+	if value, ok := floats.Get(new(big.Float).SetPrec(200).SetRat(big.NewRat(1, 2))); !ok || value != "half" {
+		t.Fail()
+	}
+}
@@ -0,0 +1,33 @@
+package collections
+
+import (
+	"reflect"
+)
+
+type (
+	Iterator interface {
+		Next() bool
+		Value() interface{}
+	}
+
+	iterator struct {
+		next    func() (reflect.Value, bool)
+		current reflect.Value
+	}
+)
+
+func newIterator(next func() (reflect.Value, bool)) *iterator {
+	return &iterator{next: next}
+}
+
+//Next 移动到下一个元素，没有更多元素时返回 false
+func (it *iterator) Next() bool {
+	var ok bool
+	it.current, ok = it.next()
+	return ok
+}
+
+//Value 获取当前元素
+func (it *iterator) Value() interface{} {
+	return it.current.Interface()
+}
@@ -0,0 +1,112 @@
+package collections_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/johnwiichang/collections"
+)
+
+type Order struct {
+	Items []float64
+	Paid  bool
+}
+
+func (o *Order) Total() (total float64) {
+	for _, item := range o.Items {
+		total += item
+	}
+	o.Items = nil
+	return
+}
+
+func (o Order) Unpaid() bool {
+	return !o.Paid
+}
+
+func positive(xs ...int) bool {
+	for _, x := range xs {
+		if x <= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func TestVariadicLambda(t *testing.T) {
+	var numbers = collections.From([]int{3, -1, 2}).List()
+	if numbers.Where(positive).Count() != 2 || numbers.First(positive) != 0 {
+		t.Fail()
+	}
+	var pairs = numbers.Select(func(i int, xs ...int) []int { return append([]int{i}, xs...) }).Slice().([][]int)
+	if !reflect.DeepEqual(pairs[1], []int{1, -1}) {
+		t.Fail()
+	}
+	var sum int
+	numbers.ForEach(func(xs ...int) { sum += xs[0] })
+	if sum != 4 {
+		t.Fail()
+	}
+	var sig = collections.NewSignature().In(reflect.TypeOf([]int{})).Out(reflect.TypeOf(true)).Variadic()
+	if collections.Matches(positive, sig) != nil || collections.Matches(func(xs []int) bool { return true }, sig) == nil {
+		t.Fail()
+	}
+	EstimateFail(t, func(t *testing.T) {
+		numbers.Where(func(xs ...string) bool { return true })
+	})
+}
+
+func TestMethodExpressionLambda(t *testing.T) {
+	var orders = []Order{{Items: []float64{1, 2}}, {Items: []float64{5}, Paid: true}}
+	var totals = collections.From(orders).List().Select((*Order).Total).Slice().([]float64)
+	if !reflect.DeepEqual(totals, []float64{3, 5}) || orders[0].Items == nil || orders[1].Items == nil {
+		t.Fail()
+	}
+	var pointers = collections.From([]*Order{&orders[0], &orders[1]}).List()
+	if pointers.Where(Order.Unpaid).Count() != 1 || pointers.Select((*Order).Total).Slice().([]float64)[1] != 5 {
+		t.Fail()
+	}
+	if collections.From(orders).List().Strict().Count(Order.Unpaid) != 1 {
+		t.Fail()
+	}
+	EstimateFail(t, func(t *testing.T) {
+		collections.From(orders).List().Strict().Select((*Order).Total)
+	})
+	EstimateFail(t, func(t *testing.T) {
+		collections.From([]int{1}).List().ForEach(func(n *int) {})
+	})
+	var err = collections.From([]*Order{nil}).List().TryForEach(func(o Order) {})
+	if !errors.Is(err, collections.ErrArgumentIsInvalid) {
+		t.Fail()
+	}
+}
+
+func TestMethodExpressionLinkedList(t *testing.T) {
+	var converted = collections.From([]Order{{Items: []float64{1}}}).List().ToLinkedList()
+	var pushed = collections.From([]Order{}).List().ToLinkedList()
+	pushed.PushBack(Order{Items: []float64{1}})
+	for _, linked := range []collections.LinkedList{converted, pushed} {
+		var sum float64
+		linked.ForEach(func(o *Order) { sum += o.Total() })
+		if sum != 1 || linked.Front().Value().(Order).Items == nil {
+			t.Fail()
+		}
+	}
+}
+
+func TestVariadicLambdaRequiresInput(t *testing.T) {
+	var numbers = collections.From([]int{65}).List()
+	var err = recoverError(func() {
+		numbers.ForEach(func(xs ...string) {})
+	})
+	var mismatched *collections.TypeNotCompatible
+	if !errors.As(err, &mismatched) || mismatched.Parameter != 0 || !strings.Contains(err.Error(), "parameter 0 is 'string'") {
+		t.Fail()
+	}
+	var sig = collections.NewSignature().Out(reflect.TypeOf(true))
+	if collections.Matches(positive, sig) == nil {
+		t.Fail()
+	}
+}
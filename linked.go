@@ -0,0 +1,297 @@
+package collections
+
+import (
+	"reflect"
+)
+
+type (
+	Node interface {
+		Handle
+		Set(element interface{})
+		Next() Node
+		Prev() Node
+	}
+
+	LinkedList interface {
+		PushFront(element interface{}) Node
+		PushBack(element interface{}) Node
+		InsertBefore(element interface{}, mark Node) Node
+		InsertAfter(element interface{}, mark Node) Node
+		MoveToFront(n Node)
+		MoveToBack(n Node)
+		Remove(n Node) interface{}
+		Splice(l LinkedList, mark ...Node) LinkedList
+		Front() Node
+		Back() Node
+		Len() int
+		Select(f interface{}) LinkedList
+		Where(f interface{}) LinkedList
+		ForEach(f interface{}) LinkedList
+		Count(f ...interface{}) int
+		Iterator() Iterator
+		List() List
+		Strict(strict ...bool) LinkedList
+
+		Type() reflect.Type
+	}
+
+	//linkOwner 节点的归属，拼接时将被拼接链表的归属指向目标链表，从而无需逐个修改节点
+	linkOwner struct {
+		list   *linkedList
+		parent *linkOwner
+	}
+
+	linkNode struct {
+		value      reflect.Value
+		prev, next *linkNode
+		owner      *linkOwner
+	}
+
+	//linkedList 带哨兵节点的双向循环链表
+	linkedList struct {
+		t     reflect.Type
+		root  linkNode
+		size  int
+		owner *linkOwner
+		strictness
+	}
+)
+
+func newLinkedList(t reflect.Type) *linkedList {
+	var lst = &linkedList{t: t}
+	lst.root.prev, lst.root.next = &lst.root, &lst.root
+	lst.owner = &linkOwner{list: lst}
+	return lst
+}
+
+//resolve 获取节点当前所属的链表（同时压缩归属路径）
+func (owner *linkOwner) resolve() *linkedList {
+	var root = owner
+	for root.parent != nil {
+		root = root.parent
+	}
+	for owner != root {
+		var parent = owner.parent
+		owner.parent, owner = root, parent
+	}
+	return root.list
+}
+
+func (n *linkNode) Value() interface{} {
+	return n.value.Interface()
+}
+
+//Set 替换节点的元素
+func (n *linkNode) Set(element interface{}) {
+	if n.owner == nil {
+		n.value = convertTo(element, n.value.Type())
+		return
+	}
+	n.value = n.owner.resolve().convertTo(element, n.value.Type())
+}
+
+func (n *linkNode) Next() Node {
+	if next := n.next; n.owner != nil && next != &n.owner.resolve().root {
+		return next
+	}
+	return nil
+}
+
+func (n *linkNode) Prev() Node {
+	if prev := n.prev; n.owner != nil && prev != &n.owner.resolve().root {
+		return prev
+	}
+	return nil
+}
+
+//node 校验节点属于当前链表
+func (lst *linkedList) node(n Node) *linkNode {
+	if node, ok := n.(*linkNode); ok && node.owner != nil && node.owner.resolve() == lst {
+		return node
+	}
+	panic(throwHandleIsInvalid(lst.t))
+}
+
+func (lst *linkedList) insert(value reflect.Value, at *linkNode) *linkNode {
+	var n = &linkNode{value: value, prev: at, next: at.next, owner: lst.owner}
+	at.next.prev, at.next = n, n
+	lst.size++
+	return n
+}
+
+func (lst *linkedList) unlink(n *linkNode) {
+	n.prev.next, n.next.prev = n.next, n.prev
+}
+
+func (lst *linkedList) move(n, at *linkNode) {
+	if n == at {
+		return
+	}
+	lst.unlink(n)
+	n.prev, n.next = at, at.next
+	at.next.prev, at.next = n, n
+}
+
+func (lst *linkedList) Type() reflect.Type {
+	return lst.t
+}
+
+func (lst *linkedList) Len() int {
+	return lst.size
+}
+
+func (lst *linkedList) Front() Node {
+	if lst.size == 0 {
+		return nil
+	}
+	return lst.root.next
+}
+
+func (lst *linkedList) Back() Node {
+	if lst.size == 0 {
+		return nil
+	}
+	return lst.root.prev
+}
+
+//Strict 为当前链表设置严格类型匹配模式（不给出参数时开启），影响写入的元素与查询函数，由当前链表产生的集合会继承该设置
+func (lst *linkedList) Strict(strict ...bool) LinkedList {
+	lst.setStrict(strict)
+	return lst
+}
+
+func (lst *linkedList) PushFront(element interface{}) Node {
+	return lst.insert(lst.convertTo(element, lst.t.Elem()), &lst.root)
+}
+
+func (lst *linkedList) PushBack(element interface{}) Node {
+	return lst.insert(lst.convertTo(element, lst.t.Elem()), lst.root.prev)
+}
+
+func (lst *linkedList) InsertBefore(element interface{}, mark Node) Node {
+	return lst.insert(lst.convertTo(element, lst.t.Elem()), lst.node(mark).prev)
+}
+
+func (lst *linkedList) InsertAfter(element interface{}, mark Node) Node {
+	return lst.insert(lst.convertTo(element, lst.t.Elem()), lst.node(mark))
+}
+
+func (lst *linkedList) MoveToFront(n Node) {
+	var node = lst.node(n)
+	if lst.root.next != node {
+		lst.move(node, &lst.root)
+	}
+}
+
+func (lst *linkedList) MoveToBack(n Node) {
+	var node = lst.node(n)
+	if lst.root.prev != node {
+		lst.move(node, lst.root.prev)
+	}
+}
+
+//Remove 移除节点，移除后节点不再可用
+func (lst *linkedList) Remove(n Node) interface{} {
+	var node = lst.node(n)
+	lst.unlink(node)
+	node.prev, node.next, node.owner = nil, nil, nil
+	lst.size--
+	return node.Value()
+}
+
+//Splice 将另一个链表的全部节点以 O(1) 移动到给定节点之后（不给出时移动到末尾），另一个链表将被清空
+//节点不经转换直接移动，因此两个链表的元素类型必须完全相同。
+func (lst *linkedList) Splice(l LinkedList, mark ...Node) LinkedList {
+	if l.Type() != lst.t {
+		panic(throwTypeNotCompatiable(lst.t.String(), l.Type()))
+	}
+	var other, at = l.(*linkedList), lst.root.prev
+	if len(mark) > 0 {
+		at = lst.node(mark[0])
+	}
+	if other == lst || other.size == 0 {
+		return lst
+	}
+	var first, last = other.root.next, other.root.prev
+	first.prev, last.next = at, at.next
+	at.next.prev, at.next = last, first
+	lst.size += other.size
+	other.owner.parent = lst.owner
+	other.owner = &linkOwner{list: other}
+	other.root.prev, other.root.next, other.size = &other.root, &other.root, 0
+	return lst
+}
+
+//Iterator 从头到尾遍历
+func (lst *linkedList) Iterator() Iterator {
+	var n = lst.root.next
+	return newIterator(func() (value reflect.Value, ok bool) {
+		if ok = n != &lst.root; ok {
+			value, n = n.value, n.next
+		}
+		return
+	})
+}
+
+//List 按顺序拷贝为 List 集合
+func (lst *linkedList) List() List {
+	var newlist, index = newList(lst.t, lst.size), 0
+	newlist.strictness = lst.strictness
+	for n := lst.root.next; n != &lst.root; n = n.next {
+		newlist.value.Index(index).Set(n.value)
+		index++
+	}
+	return newlist
+}
+
+func (lst *linkedList) ForEach(f interface{}) LinkedList {
+	var function = lst.lambda("LinkedList.ForEach", f,
+		//支持的函数签名
+		newFunc()(types.AnyTypes)(),
+		newFunc(types.Int, lst.t.Elem())(types.AnyTypes)(),
+		newFunc(lst.t.Elem())(types.AnyTypes)(),
+	)
+	var numin, index = function.Type().NumIn(), 0
+	for n := lst.root.next; n != &lst.root; n, index = n.next, index+1 {
+		var args = []reflect.Value{reflect.ValueOf(index), n.value}
+		if stopped(function.call(args[2-numin:2]...)) {
+			break
+		}
+	}
+	return lst
+}
+
+func (lst *linkedList) Select(f interface{}) LinkedList {
+	var function = lst.lambda("LinkedList.Select", f,
+		//支持的函数签名
+		newFunc(types.Int, lst.t.Elem())(types.AnyTypes)(),
+		newFunc(lst.t.Elem())(types.AnyTypes)(),
+	)
+	var newlist = newLinkedList(reflect.SliceOf(function.Type().Out(0)))
+	newlist.strictness = lst.strictness
+	var numin, index = function.Type().NumIn(), 0
+	for n := lst.root.next; n != &lst.root; n, index = n.next, index+1 {
+		var args = []reflect.Value{reflect.ValueOf(index), n.value}
+		newlist.insert(function.call(args[2-numin:2]...)[0], newlist.root.prev)
+	}
+	return newlist
+}
+
+func (lst *linkedList) Where(f interface{}) LinkedList {
+	var function = lst.lambda("LinkedList.Where", f, newFunc(lst.t.Elem())(types.Bool)())
+	var newlist = newLinkedList(lst.t)
+	newlist.strictness = lst.strictness
+	for n := lst.root.next; n != &lst.root; n = n.next {
+		if function.call(n.value)[0].Bool() {
+			newlist.insert(n.value, newlist.root.prev)
+		}
+	}
+	return newlist
+}
+
+func (lst *linkedList) Count(f ...interface{}) int {
+	if len(f) > 0 {
+		return lst.Where(f[0]).Count()
+	}
+	return lst.size
+}
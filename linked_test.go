@@ -0,0 +1,63 @@
+package collections_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/johnwiichang/collections"
+)
+
+func TestLinkedList(t *testing.T) {
+	var linked = slices.Number.ToLinkedList()
+	var front, back = linked.Front(), linked.Back()
+	var middle = linked.InsertAfter(0, front.Next())
+	linked.InsertBefore(6, front)
+	linked.MoveToFront(back)
+	if !reflect.DeepEqual(linked.List().Slice(), []int{5, 6, 1, 2, 0, 3, 4}) {
+		t.Fail()
+	}
+	if linked.Remove(middle) != 0 || linked.Len() != 6 || middle.Next() != nil {
+		t.Fail()
+	}
+	EstimateFail(t, func(*testing.T) {
+		linked.Remove(middle)
+	})
+	if linked.Where(func(n int) bool { return n > 2 }).Count() != 4 || linked.Count(func(n int) bool { return n < 2 }) != 1 {
+		t.Fail()
+	}
+	var strs = linked.Select(func(i, n int) int { return i * n }).List().Slice()
+	if !reflect.DeepEqual(strs, []int{0, 6, 2, 6, 12, 20}) {
+		t.Fail()
+	}
+}
+
+func TestLinkedListSplice(t *testing.T) {
+	var a, b, c = slices.Number.ToLinkedList(), slices.Number.ToLinkedList(), slices.Number.ToLinkedList()
+	var node = c.Front()
+	b.Splice(c)
+	a.Splice(b, a.Front())
+	if a.Len() != 15 || b.Len() != 0 || c.Len() != 0 {
+		t.Fail()
+	}
+	a.MoveToBack(node)
+	if a.Back() != node || node.Next() != nil || a.Front().Next().Value() != 1 {
+		t.Fail()
+	}
+	var sum int
+	a.ForEach(func(n int) bool {
+		sum += n
+		return sum < 10
+	})
+	if sum != 11 {
+		t.Fail()
+	}
+	EstimateFail(t, func(*testing.T) {
+		c.Remove(node)
+	})
+	EstimateFail(t, func(*testing.T) {
+		a.Splice(collections.From([]string{}).List().ToLinkedList())
+	})
+	EstimateFail(t, func(*testing.T) {
+		a.Splice(collections.From([]int64{1}).List().ToLinkedList())
+	})
+}
@@ -1,8 +1,11 @@
 package collections
 
 import (
+	"container/heap"
 	"reflect"
 	"sort"
+	"strings"
+	"unicode/utf8"
 )
 
 type (
@@ -10,9 +13,20 @@ type (
 		Slice(slice ...interface{}) interface{}
 		Select(f interface{}) List
 		SelectMany(f interface{}) List
+		Descendants(f interface{}, options ...TraverseOptions) List
+		Flatten(f interface{}, options ...TraverseOptions) List
+		BuildTree(id, parent, children interface{}) List
 		ForEach(f interface{}) List
+		TryForEach(f interface{}) error
 		ToDictionary(f ...interface{}) Dictionary
+		ToLookup(key interface{}, value ...interface{}) Lookup
+		CountBy(key interface{}) Counter
+		ToCounter() Counter
 		Sort(less ...interface{}) List
+		OrderBy(less ...interface{}) List
+		Min(less ...interface{}) interface{}
+		Max(less ...interface{}) interface{}
+		BinarySearch(target interface{}) (int, bool)
 		Reverse() List
 		Distinct() List
 		Where(f interface{}) List
@@ -28,6 +42,15 @@ type (
 		Skip(length int) List
 		Take(num int) List
 		Resize(length ...int) List
+		JoinString(sep string) string
+		ToString() string
+		ToPriorityQueue(f ...interface{}) PriorityQueue
+		ToLinkedList() LinkedList
+		ToImmutable() ImmutableList
+		ToTrie() Trie
+		ToGraph(f interface{}) Graph
+		ToBitSet() BitSet
+		Strict(strict ...bool) List
 
 		Type() reflect.Type
 	}
@@ -35,6 +58,7 @@ type (
 	list struct {
 		t     reflect.Type
 		value *reflect.Value
+		strictness
 
 		skip int
 	}
@@ -54,6 +78,27 @@ func (lst *list) Type() reflect.Type {
 	return lst.t
 }
 
+//Strict 为当前查询设置严格类型匹配模式（不给出参数时开启），由当前列表产生的列表会继承该设置
+func (lst *list) Strict(strict ...bool) List {
+	lst.setStrict(strict)
+	return lst
+}
+
+//fill 将第 i 个元素的调用参数（索引、元素）填入 args，索引仅在函数需要时装箱
+func (lst *list) fill(args []reflect.Value, i, numin int) {
+	args[1] = lst.value.Index(i)
+	if numin == 2 {
+		args[0] = reflect.ValueOf(i)
+	}
+}
+
+//derive 创建继承当前列表设置的新列表
+func (lst *list) derive(t reflect.Type, cap ...int) *list {
+	var newlist = newList(t, cap...)
+	newlist.strict = lst.strict
+	return newlist
+}
+
 func (lst *list) Skip(length int) List {
 	lst.skip = length
 	return lst
@@ -61,7 +106,7 @@ func (lst *list) Skip(length int) List {
 
 //Resize 从跳过后选择一定数量的元素创建新列表（元素不够时不报错但是长度会不足）
 func (lst *list) Take(num int) List {
-	var newlist, length = newList(lst.t), lst.Count()
+	var newlist, length = lst.derive(lst.t), lst.Count()
 	if length > lst.skip && num > 0 {
 		if max := length - lst.skip; max < num {
 			num = max
@@ -90,67 +135,75 @@ func (lst *list) Resize(length ...int) List {
 }
 
 func (lst *list) Select(f interface{}) List {
-	function := reflect.ValueOf(f)
-	if err := typeRequired(function.Type(),
+	if result, ok := fastSelect(lst.value.Interface(), f); ok {
+		var value = reflect.ValueOf(result)
+		return &list{t: value.Type(), value: &value, strictness: lst.strictness}
+	}
+	var function = lst.lambda("List.Select", f,
 		//支持的函数签名
 		newFunc(types.Int, lst.t.Elem())(types.AnyTypes)(),
 		newFunc(lst.t.Elem())(types.AnyTypes)(),
-	); err != nil {
-		panic(err)
-	}
-	var newlist = newList(reflect.SliceOf(function.Type().Out(0)), lst.value.Len())
+	)
+	var newlist = lst.derive(reflect.SliceOf(function.Type().Out(0)), lst.value.Len())
 	var numin = function.Type().NumIn()
-	lst.ForEach(func(i int, val interface{}) {
-		var args = []reflect.Value{reflect.ValueOf(i), reflect.ValueOf(val)}
-		newlist.value.Index(i).Set(call(function, args[2-numin:2]...)[0])
-	})
+	var args = make([]reflect.Value, 2)
+	for i := 0; i < lst.value.Len(); i++ {
+		lst.fill(args, i, numin)
+		newlist.value.Index(i).Set(function.call(args[2-numin:2]...)[0])
+	}
 	return newlist
 }
 
 func (lst *list) SelectMany(f interface{}) List {
-	function := reflect.ValueOf(f)
-	if err := typeRequired(function.Type(),
+	var function = lst.lambda("List.SelectMany", f,
 		//支持的函数签名
 		newFunc(types.Int, lst.t.Elem())(types.Slice)(),
 		newFunc(lst.t.Elem())(types.Slice)(),
-	); err != nil {
-		panic(err)
-	}
-	var newlist = newList(reflect.SliceOf(function.Type().Out(0).Elem()))
+	)
+	var newlist = lst.derive(reflect.SliceOf(function.Type().Out(0).Elem()))
 	var numin = function.Type().NumIn()
-	lst.ForEach(func(i int, val interface{}) {
-		var args = []reflect.Value{reflect.ValueOf(i), reflect.ValueOf(val)}
-		newlist.value.Set(reflect.AppendSlice(*newlist.value, call(function, args[2-numin:2]...)[0]))
-	})
+	var args = make([]reflect.Value, 2)
+	for i := 0; i < lst.value.Len(); i++ {
+		lst.fill(args, i, numin)
+		newlist.value.Set(reflect.AppendSlice(*newlist.value, function.call(args[2-numin:2]...)[0]))
+	}
 	return newlist
 }
 
 func (lst *list) ForEach(f interface{}) List {
-	val, function := lst.value, reflect.ValueOf(f)
-	if err := typeRequired(function.Type(),
+	lst.each("List.ForEach", f)
+	return lst
+}
+
+//TryForEach 与 ForEach 相同，但遍历函数返回的 error、函数发生的 panic 与签名错误会作为错误返回
+func (lst *list) TryForEach(f interface{}) (err error) {
+	defer recovered("List.TryForEach", &err)
+	return lst.each("List.TryForEach", f)
+}
+
+//each 遍历元素，返回使遍历终止的 error
+func (lst *list) each(operator string, f interface{}) error {
+	if fastForEach(operator, lst.value.Interface(), f) {
+		return nil
+	}
+	var val, function = lst.value, lst.lambda(operator, f,
 		//支持的函数签名
 		newFunc()(types.AnyTypes)(),
 		newFunc(types.Int, lst.t.Elem())(types.AnyTypes)(),
 		newFunc(lst.t.Elem())(types.AnyTypes)(),
-	); err != nil {
-		panic(err)
-	}
+	)
 	var numin = function.Type().NumIn()
+	var args = make([]reflect.Value, 2)
 	for i := 0; i < val.Len(); i++ {
-		var args = []reflect.Value{reflect.ValueOf(i), val.Index(i)}
-		var back = call(function, args[2-numin:2]...)
-		if len(back) > 0 {
-			var first, last = back[0], back[len(back)-1].Interface()
-			var stop = first.Kind() == reflect.Bool && !first.Bool()
-			if !stop && last != nil {
-				_, stop = last.(error)
-			}
-			if stop {
-				break
+		lst.fill(args, i, numin)
+		if back := function.call(args[2-numin:2]...); stopped(back) {
+			if err := failed(back); err != nil {
+				return throwLambdaFailed(operator, i, err)
 			}
+			break
 		}
 	}
-	return lst
+	return nil
 }
 
 func (lst *list) Slice(slice ...interface{}) interface{} {
@@ -176,23 +229,17 @@ func (lst *list) ToDictionary(f ...interface{}) Dictionary {
 	if len(f) == 0 {
 		f = []interface{}{func() interface{} { return nil }}
 	}
-	var functions = []reflect.Value{reflect.ValueOf(f[0]), makeConflictHandler(lst.t.Elem(), 1)}
-	if len(f) == 2 {
-		functions[1] = reflect.ValueOf(f[1])
-	}
-	if err := typeRequired(functions[0].Type(),
+	var functions = []lambdaFunc{lst.lambda("List.ToDictionary", f[0],
 		//支持的函数签名
 		newFunc()(types.AnyType, types.AnyTypes)(),
 		newFunc(types.Int, lst.t.Elem())(types.AnyType, types.AnyTypes)(),
 		newFunc(lst.t.Elem())(types.AnyType, types.AnyTypes)(),
-	); err != nil {
-		panic(err)
-	}
-	if err := typeRequired(functions[1].Type(),
-		//支持的函数签名
-		newFunc(lst.t.Elem(), types.AnyType)(lst.t.Elem())(),
-	); err != nil {
-		panic(err)
+	), makeConflictHandler(lst.t.Elem(), 1)}
+	if len(f) == 2 {
+		functions[1] = lst.lambda("List.ToDictionary", f[1],
+			//支持的函数签名
+			newFunc(lst.t.Elem(), types.AnyType)(lst.t.Elem())(),
+		)
 	}
 	var funct = functions[0].Type()
 	var kt, vt = lst.t.Elem(), funct.Out(0)
@@ -200,9 +247,10 @@ func (lst *list) ToDictionary(f ...interface{}) Dictionary {
 		kt, vt = vt, funct.Out(1)
 	}
 	var newmap, numin = newDictionary(reflect.MapOf(kt, vt), lst.value.Len()), functions[0].Type().NumIn()
-	lst.ForEach(func(i int, val interface{}) {
-		var args = []reflect.Value{reflect.ValueOf(i), reflect.ValueOf(val)}
-		var back = call(functions[0], args[2-numin:2]...)
+	var args = make([]reflect.Value, 2)
+	for i := 0; i < lst.value.Len(); i++ {
+		lst.fill(args, i, numin)
+		var back = functions[0].call(args[2-numin:2]...)
 		var key, value reflect.Value
 		if len(back) > 1 {
 			key, value = back[0], back[1]
@@ -210,35 +258,187 @@ func (lst *list) ToDictionary(f ...interface{}) Dictionary {
 			key, value = args[1], back[0]
 		}
 		if old := newmap.value.MapIndex(key); old.IsValid() {
-			value = call(functions[1], old, value)[0]
+			value = functions[1].call(old, value)[0]
 		}
 		newmap.value.SetMapIndex(key, value)
-	})
+	}
 	return newmap
 }
 
+//ToPriorityQueue 将 List 转换为优先队列
+//可以给出比较函数 func(T, T) bool 或键选择函数 func(T) K，不给出时使用有序类型的自然顺序（最小者优先）。
+func (lst *list) ToPriorityQueue(f ...interface{}) PriorityQueue {
+	var pq = newPriorityQueue(lst.t, makeLessWith(lst.strictTypes(), "List.ToPriorityQueue", lst.t.Elem(), f...))
+	pq.strictness = lst.strictness
+	for i := 0; i < lst.value.Len(); i++ {
+		pq.heap().Push(&priorityItem{value: clone(lst.value.Index(i)), owner: pq})
+	}
+	heap.Init(pq.heap())
+	return pq
+}
+
+//ToLookup 将 List 按键分组为一对多的 Lookup 集合
+//键选择函数与值选择函数均支持 func(T) 与 func(int, T) 两种签名，不给出值选择函数时值为元素本身。
+func (lst *list) ToLookup(key interface{}, value ...interface{}) Lookup {
+	var selectors = []interface{}{key}
+	if len(value) > 0 {
+		selectors = append(selectors, value[0])
+	}
+	var functions = make([]lambdaFunc, len(selectors))
+	for i, f := range selectors {
+		functions[i] = lst.lambda("List.ToLookup", f,
+			//支持的函数签名
+			newFunc(types.Int, lst.t.Elem())(types.AnyType)(),
+			newFunc(lst.t.Elem())(types.AnyType)(),
+		)
+	}
+	var vt = functions[len(functions)-1].Type().Out(0)
+	if len(functions) == 1 {
+		vt = lst.t.Elem()
+	}
+	var lkp = newLookup(functions[0].Type().Out(0), vt)
+	var args = make([]reflect.Value, 2)
+	for i := 0; i < lst.value.Len(); i++ {
+		lst.fill(args, i, 2)
+		var kv = []reflect.Value{args[1], args[1]}
+		for index, function := range functions {
+			var numin = function.Type().NumIn()
+			kv[index] = function.call(args[2-numin:2]...)[0]
+		}
+		lkp.add(clone(kv[0]), clone(kv[1]))
+	}
+	return lkp
+}
+
+//CountBy 按键统计元素数量
+//键选择函数支持 func(T) 与 func(int, T) 两种签名。
+func (lst *list) CountBy(key interface{}) Counter {
+	var function = lst.lambda("List.CountBy", key,
+		//支持的函数签名
+		newFunc(types.Int, lst.t.Elem())(types.AnyType)(),
+		newFunc(lst.t.Elem())(types.AnyType)(),
+	)
+	var c, numin = newCounter(function.Type().Out(0)), function.Type().NumIn()
+	var args = make([]reflect.Value, 2)
+	for i := 0; i < lst.value.Len(); i++ {
+		lst.fill(args, i, numin)
+		c.add(function.call(args[2-numin:2]...)[0], 1)
+	}
+	return c
+}
+
+//ToCounter 统计每个元素出现的次数
+func (lst *list) ToCounter() Counter {
+	var c = newCounter(lst.t.Elem())
+	for i := 0; i < lst.value.Len(); i++ {
+		c.add(clone(lst.value.Index(i)), 1)
+	}
+	return c
+}
+
+//ToLinkedList 按顺序拷贝为双向链表
+func (lst *list) ToLinkedList() LinkedList {
+	var linked = newLinkedList(lst.t)
+	linked.strictness = lst.strictness
+	for i := 0; i < lst.value.Len(); i++ {
+		linked.insert(clone(lst.value.Index(i)), linked.root.prev)
+	}
+	return linked
+}
+
+//ToImmutable 拷贝为持久化的不可变列表
+func (lst *list) ToImmutable() ImmutableList {
+	var il = newImmutableList(lst.t)
+	for i := 0; i < lst.value.Len(); i++ {
+		il = il.push(clone(lst.value.Index(i)))
+	}
+	return il
+}
+
+//ToTrie 将字符串列表转换为前缀树（值为 true）
+//如果元素不为字符串，那么会抛出 panic 异常。
+func (lst *list) ToTrie() Trie {
+	var tr = newTrie(reflect.MapOf(lst.t.Elem(), types.Bool))
+	for i := 0; i < lst.value.Len(); i++ {
+		tr.insert(lst.value.Index(i).String(), reflect.ValueOf(true))
+	}
+	return tr
+}
+
+//ToGraph 将边的列表转换为有向图
+//边选择函数为 func(T) (K, K) 形式，返回边的起点与终点。
+func (lst *list) ToGraph(f interface{}) Graph {
+	var function = lst.lambda("List.ToGraph", f,
+		//支持的函数签名
+		newFunc(lst.t.Elem())(types.AnyType, types.AnyType)(),
+	)
+	var kt = function.Type().Out(0)
+	if err := typeRequired(function.Type().Out(1), kt); err != nil {
+		panic(err)
+	}
+	var g = newGraph(kt)
+	for i := 0; i < lst.value.Len(); i++ {
+		var back = function.call(lst.value.Index(i))
+		var from = g.node(back[0])
+		g.edges[from] = append(g.edges[from], g.node(back[1].Convert(kt)))
+	}
+	return g
+}
+
+//ToBitSet 将非负整数列表转换为位集合
+//如果元素不为整数，那么会抛出 panic 异常。
+func (lst *list) ToBitSet() BitSet {
+	var bs, integer = &bitset{}, func(item reflect.Value) int { return int(item.Int()) }
+	switch lst.t.Elem().Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		integer = func(item reflect.Value) int { return int(item.Uint()) }
+	default:
+		panic(throwTypeNotCompatiable("[]int", lst.t))
+	}
+	for i := 0; i < lst.value.Len(); i++ {
+		bs.Set(integer(lst.value.Index(i)))
+	}
+	return bs
+}
+
+//Distinct 去除重复元素
+//元素类型注册了哈希函数时按哈希分组比较，没有钩子与注册比较函数的基础类型直接使用 map 去重，否则逐个比较。
 func (lst *list) Distinct() List {
-	elem := lst.t.Elem()
-	compare := getCompareHook(elem, elem)
-	if compare == nil {
-		var function = reflect.ValueOf(func(a, b interface{}) bool {
-			return a == b
-		})
-		compare = &function
+	var hasher, index = getHasher(lst.t.Elem()), keyIndex(nil)
+	if hasher == nil {
+		index = newKeyIndex(lst.t.Elem())
 	}
 	var values []reflect.Value
-	lst.ForEach(func(obj interface{}) {
+	var buckets = make(map[uint64][]reflect.Value)
+	for i := 0; i < lst.value.Len(); i++ {
+		var item, candidates = lst.value.Index(i), values
+		if index != nil {
+			if _, existed := index[item.Interface()]; !existed {
+				index[item.Interface()] = len(values)
+				values = append(values, item)
+			}
+			continue
+		}
+		var hash uint64
+		if hasher != nil {
+			hash = call(*hasher, item)[0].Uint()
+			candidates = buckets[hash]
+		}
 		var existed bool
-		for _, value := range values {
-			if existed = compare.Call([]reflect.Value{reflect.ValueOf(obj), value})[0].Bool(); existed {
+		for _, value := range candidates {
+			if existed = valueCompare(item, value); existed {
 				break
 			}
 		}
 		if !existed {
-			values = append(values, reflect.ValueOf(obj))
+			values = append(values, item)
+			if hasher != nil {
+				buckets[hash] = append(buckets[hash], item)
+			}
 		}
-	})
-	var newlist = newList(lst.t)
+	}
+	var newlist = lst.derive(lst.t)
 	newlist.value.Set(reflect.Append(*newlist.value, values...))
 	return newlist
 }
@@ -251,17 +451,19 @@ func (lst *list) Count(f ...interface{}) int {
 }
 
 func (lst *list) Where(f interface{}) List {
-	compare := reflect.ValueOf(f)
-	if err := typeRequired(compare.Type(), newFunc(lst.t.Elem())(types.Bool)()); err != nil {
-		panic(err)
+	if result, ok := fastWhere(lst.value.Interface(), f); ok {
+		var newlist = lst.derive(lst.t)
+		newlist.value.Set(reflect.ValueOf(result))
+		return newlist
 	}
+	var compare = lst.lambda("List.Where", f, newFunc(lst.t.Elem())(types.Bool)())
 	var values []reflect.Value
-	lst.ForEach(func(obj interface{}) {
-		if compare.Call([]reflect.Value{reflect.ValueOf(obj)})[0].Bool() {
-			values = append(values, reflect.ValueOf(obj))
+	for i := 0; i < lst.value.Len(); i++ {
+		if item := lst.value.Index(i); compare.call(item)[0].Bool() {
+			values = append(values, item)
 		}
-	})
-	var newlist = newList(lst.t)
+	}
+	var newlist = lst.derive(lst.t)
 	newlist.value.Set(reflect.Append(*newlist.value, values...))
 	return newlist
 }
@@ -297,35 +499,85 @@ func (lst *list) Any(elements ...interface{}) bool {
 	return false
 }
 
+//Sort 就地排序
+//可以给出比较函数 func(T, T) bool 或键选择函数 func(T) K，不给出时使用有序类型的自然顺序或 CompareTo*、Less* 钩子。
 func (lst *list) Sort(less ...interface{}) List {
 	var val = lst.value
-	var slice sort.Interface
-	switch lst.t.Elem().Kind() {
-	case reflect.Int:
-		slice = sort.IntSlice(val.Interface().([]int))
-	case reflect.Float64:
-		slice = sort.Float64Slice(val.Interface().([]float64))
-	case reflect.String:
-		slice = sort.StringSlice(val.Interface().([]string))
-	default:
-		if len(less) == 0 {
-			panic(throwMethodHasNoImplement("less", lst.t).Error())
+	if len(less) == 0 {
+		switch slice := val.Interface().(type) {
+		case []int:
+			sort.Ints(slice)
+			return lst
+		case []float64:
+			sort.Float64s(slice)
+			return lst
+		case []string:
+			sort.Strings(slice)
+			return lst
 		}
 	}
-	if len(less) > 0 {
-		var function = reflect.ValueOf(less[0])
-		var elem = lst.t.Elem()
-		var estimate = newFunc(elem, elem)(types.Bool)()
-		if err := typeRequired(function.Type(), estimate); err != nil {
-			panic(err)
+	var function = makeLessWith(lst.strictTypes(), "List.Sort", lst.t.Elem(), less...)
+	sort.Slice(val.Interface(), func(i, j int) bool {
+		return function(val.Index(i), val.Index(j))
+	})
+	return lst
+}
+
+//OrderBy 获取排序后的新列表（不改变当前列表，排序稳定）
+//参数与 Sort 相同。
+func (lst *list) OrderBy(less ...interface{}) List {
+	var function = makeLessWith(lst.strictTypes(), "List.OrderBy", lst.t.Elem(), less...)
+	var newlist = lst.derive(lst.t, lst.value.Len())
+	reflect.Copy(*newlist.value, *lst.value)
+	var val = newlist.value
+	sort.SliceStable(val.Interface(), func(i, j int) bool {
+		return function(val.Index(i), val.Index(j))
+	})
+	return newlist
+}
+
+//Min 获取最小的元素
+//参数与 Sort 相同，如果列表为空，那么会抛出 panic 异常。
+func (lst *list) Min(less ...interface{}) interface{} {
+	return lst.extreme(makeLessWith(lst.strictTypes(), "List.Min", lst.t.Elem(), less...))
+}
+
+//Max 获取最大的元素
+//参数与 Sort 相同，如果列表为空，那么会抛出 panic 异常。
+func (lst *list) Max(less ...interface{}) interface{} {
+	var function = makeLessWith(lst.strictTypes(), "List.Max", lst.t.Elem(), less...)
+	return lst.extreme(func(a, b reflect.Value) bool { return function(b, a) })
+}
+
+//extreme 获取按比较函数排在最前的元素（相同时取第一个）
+func (lst *list) extreme(less func(a, b reflect.Value) bool) interface{} {
+	if lst.value.Len() == 0 {
+		panic(throwCollectionIsEmpty(lst.t))
+	}
+	var result = lst.value.Index(0)
+	for i := 1; i < lst.value.Len(); i++ {
+		if item := lst.value.Index(i); less(item, result) {
+			result = item
 		}
-		sort.Slice(val.Interface(), func(i, j int) bool {
-			var args = []reflect.Value{val.Index(i), val.Index(j)}
-			return call(function, args...)[0].Bool()
-		})
 	}
-	sort.Sort(slice)
-	return lst
+	return result.Interface()
+}
+
+//BinarySearch 在已排序的列表中二分查找目标，返回目标的位置（不存在时为插入位置）以及是否找到
+//目标可以与元素类型不同，此时使用 CompareTo*、Less* 钩子比较。
+func (lst *list) BinarySearch(target interface{}) (int, bool) {
+	var value, elem = reflect.ValueOf(target), lst.t.Elem()
+	if target == nil {
+		value = reflect.Zero(elem)
+	}
+	var less, greater = typeLess(elem, value.Type()), typeLess(value.Type(), elem)
+	if less == nil || greater == nil {
+		panic(throwMethodHasNoImplement("CompareTo", elem))
+	}
+	var index = sort.Search(lst.value.Len(), func(i int) bool {
+		return !less(lst.value.Index(i), value)
+	})
+	return index, index < lst.value.Len() && !greater(value, lst.value.Index(index))
 }
 
 func (lst *list) Reverse() List {
@@ -343,66 +595,113 @@ func (lst *list) Concat(l List) List {
 	if err := typeRequired(l.Type(), lst.t); err != nil {
 		panic(err)
 	}
-	var newlist = newList(lst.t)
+	var newlist = lst.derive(lst.t)
 	newlist.value.Set(reflect.AppendSlice(*lst.value, reflect.ValueOf(l.Slice())))
 	return newlist
 }
 
 func (lst *list) First(obj interface{}) (index int) {
-	index = -1
-	var function = reflect.ValueOf(obj)
-	if function.Kind() != reflect.Func {
-		function = reflect.ValueOf(func(element interface{}) bool {
-			return obj == element
-		})
-	}
-	lst.ForEach(func(i int, item interface{}) (next bool) {
-		if next = !call(function, reflect.ValueOf(item))[0].Bool(); !next {
-			index = i
+	var match = lst.matcher("List.First", obj)
+	for i := 0; i < lst.value.Len(); i++ {
+		if match(lst.value.Index(i)) {
+			return i
 		}
-		return
-	})
-	return
+	}
+	return -1
 }
 
 func (lst *list) Last(obj interface{}) (index int) {
-	index = -1
-	var function = reflect.ValueOf(obj)
-	if function.Kind() != reflect.Func {
-		function = reflect.ValueOf(func(element interface{}) bool {
-			return obj == element
-		})
-	}
-	defer lst.Reverse()
-	lst.Reverse().ForEach(func(i int, item interface{}) (next bool) {
-		if next = !call(function, reflect.ValueOf(item))[0].Bool(); !next {
-			index = i
+	var match = lst.matcher("List.Last", obj)
+	for i := lst.value.Len() - 1; i >= 0; i-- {
+		if match(lst.value.Index(i)) {
+			return i
 		}
-		return
-	})
-	return
+	}
+	return -1
+}
+
+//matcher 将查找的目标转换为匹配函数，目标为函数时作为 func(T) bool 条件，否则比较元素与目标是否相等
+func (lst *list) matcher(operator string, obj interface{}) func(reflect.Value) bool {
+	if reflect.ValueOf(obj).Kind() != reflect.Func {
+		var target = reflect.ValueOf(obj)
+		return func(item reflect.Value) bool { return valueCompare(item, target) }
+	}
+	var function = lst.lambda(operator, obj, newFunc(lst.t.Elem())(types.Bool)())
+	return func(item reflect.Value) bool { return function.call(item)[0].Bool() }
 }
 
 func (lst *list) Intersect(l List) List {
-	var newlist = newList(lst.t)
-	lst.ForEach(func(item interface{}) {
-		if l.Any(item) {
-			newlist.value.Set(reflect.Append(*newlist.value, reflect.ValueOf(item)))
+	var newlist = lst.derive(lst.t)
+	for i := 0; i < lst.value.Len(); i++ {
+		if item := lst.value.Index(i); l.Any(item.Interface()) {
+			newlist.value.Set(reflect.Append(*newlist.value, item))
 		}
-	})
+	}
 	return newlist
 }
 
 func (lst *list) Except(l List) List {
-	var newlist = newList(lst.t)
-	lst.ForEach(func(item interface{}) {
-		if !l.Any(item) {
-			newlist.value.Set(reflect.Append(*newlist.value, reflect.ValueOf(item)))
+	var newlist = lst.derive(lst.t)
+	for i := 0; i < lst.value.Len(); i++ {
+		if item := lst.value.Index(i); !l.Any(item.Interface()) {
+			newlist.value.Set(reflect.Append(*newlist.value, item))
 		}
-	})
+	}
 	return newlist
 }
 
 func (lst *list) Union(l List) List {
 	return lst.Concat(l).Distinct()
 }
+
+//JoinString 使用分隔符将 []rune、[]byte 或 []string 列表拼接为字符串
+//[]byte 列表按 UTF-8 字符插入分隔符（无效字节原样保留），其他类型会抛出 panic 异常。
+func (lst *list) JoinString(sep string) string {
+	var kind = lst.t.Elem().Kind()
+	if kind != reflect.Int32 && kind != reflect.Uint8 && kind != reflect.String {
+		panic(throwTypeNotCompatiable("[]rune', '[]byte', '[]string", lst.t))
+	}
+	if kind == reflect.Uint8 {
+		return lst.joinBytes(sep)
+	}
+	var builder strings.Builder
+	for i := 0; i < lst.value.Len(); i++ {
+		if i > 0 {
+			builder.WriteString(sep)
+		}
+		var item = lst.value.Index(i)
+		if kind == reflect.Int32 {
+			builder.WriteRune(rune(item.Int()))
+		} else {
+			builder.WriteString(item.String())
+		}
+	}
+	return builder.String()
+}
+
+//joinBytes 按 UTF-8 字符拼接 []byte 列表，避免分隔符拆开多字节字符
+func (lst *list) joinBytes(sep string) string {
+	var data = make([]byte, lst.value.Len())
+	for i := range data {
+		data[i] = byte(lst.value.Index(i).Uint())
+	}
+	if sep == "" {
+		return string(data)
+	}
+	var builder strings.Builder
+	for len(data) > 0 {
+		if builder.Len() > 0 {
+			builder.WriteString(sep)
+		}
+		var _, size = utf8.DecodeRune(data)
+		builder.Write(data[:size])
+		data = data[size:]
+	}
+	return builder.String()
+}
+
+//ToString 将 []rune、[]byte 或 []string 列表直接拼接为字符串
+//等价于 `.JoinString("")`。
+func (lst *list) ToString() string {
+	return lst.JoinString("")
+}
@@ -25,6 +25,21 @@ func (i *Int) EqualsTo(n int) bool {
 	return i.Value == n
 }
 
+func (i *Int) CompareToInt(n int) int {
+	return i.Value - n
+}
+
+type Version struct {
+	Major, Minor int
+}
+
+func (v Version) CompareTo(other Version) int {
+	if v.Major != other.Major {
+		return v.Major - other.Major
+	}
+	return v.Minor - other.Minor
+}
+
 func TestListBasicCopy(t *testing.T) {
 	var slice, dstSlice = []int{1, 2, 3, 4, 5}, make([]int, 2)
 	if reflect.DeepEqual(slice, slices.Number.Slice(&dstSlice)) {
@@ -194,3 +209,35 @@ func TestSliceSkipAndResize(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestSliceOrderHook(t *testing.T) {
+	var versions = collections.From([]Version{{1, 2}, {0, 9}, {1, 0}}).List()
+	var ordered = versions.OrderBy()
+	if !reflect.DeepEqual(ordered.Slice(), []Version{{0, 9}, {1, 0}, {1, 2}}) || versions.Slice().([]Version)[0] != (Version{1, 2}) {
+		t.Fail()
+	}
+	if versions.Min() != (Version{0, 9}) || versions.Max() != (Version{1, 2}) {
+		t.Fail()
+	}
+	if !reflect.DeepEqual(versions.Sort().Slice(), ordered.Slice()) {
+		t.Fail()
+	}
+	if index, found := ordered.BinarySearch(Version{1, 1}); found || index != 2 {
+		t.Fail()
+	}
+	if index, found := slices.Struct.BinarySearch(3); !found || index != 2 {
+		t.Fail()
+	}
+	if index, found := slices.Number.BinarySearch(6); found || index != 5 {
+		t.Fail()
+	}
+	if slices.Struct.Max(func(i *Int) int { return -i.Value }).(*Int).Value != 1 {
+		t.Fail()
+	}
+	EstimateFail(t, func(*testing.T) {
+		slices.Struct.Min()
+	})
+	EstimateFail(t, func(*testing.T) {
+		collections.From([]int{}).List().Max()
+	})
+}
@@ -0,0 +1,162 @@
+package collections
+
+import (
+	"reflect"
+)
+
+type (
+	Lookup interface {
+		Get(key interface{}) List
+		Add(key interface{}, values ...interface{}) Lookup
+		Remove(key interface{}) bool
+		RemoveValue(key, value interface{}) bool
+		Contains(key interface{}) bool
+		Count(key ...interface{}) int
+		Keys() List
+		Dictionary() Dictionary
+
+		Type() reflect.Type
+	}
+
+	grouping struct {
+		key    reflect.Value
+		values reflect.Value
+	}
+
+	//lookup 一对多映射集合，按插入顺序保存分组
+	//键的比较使用 EqualsTo* 钩子，没有钩子与注册比较函数的基础类型键使用 index 直接查找。
+	lookup struct {
+		t      reflect.Type
+		groups []*grouping
+		index  keyIndex
+	}
+)
+
+func newLookup(kt, vt reflect.Type) *lookup {
+	return &lookup{t: reflect.MapOf(kt, reflect.SliceOf(vt)), index: newKeyIndex(kt)}
+}
+
+func (lkp *lookup) Type() reflect.Type {
+	return lkp.t
+}
+
+//find 查找键对应的分组位置，不存在时返回 -1
+func (lkp *lookup) find(key reflect.Value) int {
+	if lkp.index != nil {
+		if index, existed := lkp.index[key.Interface()]; existed {
+			return index
+		}
+		return -1
+	}
+	for index, group := range lkp.groups {
+		if valueCompare(group.key, key) {
+			return index
+		}
+	}
+	return -1
+}
+
+func (lkp *lookup) add(key, value reflect.Value) {
+	var index = lkp.find(key)
+	if index < 0 {
+		index = len(lkp.groups)
+		lkp.groups = append(lkp.groups, &grouping{key: key, values: reflect.MakeSlice(lkp.t.Elem(), 0, 1)})
+		if lkp.index != nil {
+			lkp.index[key.Interface()] = index
+		}
+	}
+	var group = lkp.groups[index]
+	group.values = reflect.Append(group.values, value)
+}
+
+//remove 移除位置上的分组
+func (lkp *lookup) remove(index int) {
+	lkp.groups = append(lkp.groups[:index], lkp.groups[index+1:]...)
+	if lkp.index != nil {
+		lkp.index.removeAt(index)
+	}
+}
+
+//Get 获取键对应的全部值（键不存在时为空列表）
+func (lkp *lookup) Get(key interface{}) List {
+	var newlist = newList(lkp.t.Elem())
+	if index := lkp.find(convertTo(key, lkp.t.Key())); index >= 0 {
+		newlist.value.Set(reflect.AppendSlice(*newlist.value, lkp.groups[index].values))
+	}
+	return newlist
+}
+
+func (lkp *lookup) Add(key interface{}, values ...interface{}) Lookup {
+	var k = convertTo(key, lkp.t.Key())
+	for _, value := range values {
+		lkp.add(k, convertTo(value, lkp.t.Elem().Elem()))
+	}
+	return lkp
+}
+
+//Remove 移除键及其全部值
+func (lkp *lookup) Remove(key interface{}) bool {
+	var index = lkp.find(convertTo(key, lkp.t.Key()))
+	if index < 0 {
+		return false
+	}
+	lkp.remove(index)
+	return true
+}
+
+//RemoveValue 移除键对应的第一个相等的值，分组为空时一并移除键
+func (lkp *lookup) RemoveValue(key, value interface{}) bool {
+	var index = lkp.find(convertTo(key, lkp.t.Key()))
+	if index < 0 {
+		return false
+	}
+	var group, target = lkp.groups[index], convertTo(value, lkp.t.Elem().Elem())
+	for i := 0; i < group.values.Len(); i++ {
+		if valueCompare(group.values.Index(i), target) {
+			group.values = reflect.AppendSlice(group.values.Slice(0, i), group.values.Slice(i+1, group.values.Len()))
+			if group.values.Len() == 0 {
+				lkp.remove(index)
+			}
+			return true
+		}
+	}
+	return false
+}
+
+func (lkp *lookup) Contains(key interface{}) bool {
+	return lkp.find(convertTo(key, lkp.t.Key())) >= 0
+}
+
+//Count 获取值的总数，给出键时获取该键对应值的数量
+func (lkp *lookup) Count(key ...interface{}) (count int) {
+	if len(key) > 0 {
+		if index := lkp.find(convertTo(key[0], lkp.t.Key())); index >= 0 {
+			count = lkp.groups[index].values.Len()
+		}
+		return
+	}
+	for _, group := range lkp.groups {
+		count += group.values.Len()
+	}
+	return
+}
+
+//Keys 按插入顺序获取键集
+func (lkp *lookup) Keys() List {
+	var keys = newList(reflect.SliceOf(lkp.t.Key()), len(lkp.groups))
+	for index, group := range lkp.groups {
+		keys.value.Index(index).Set(group.key)
+	}
+	return keys
+}
+
+//Dictionary 转换为值为切片的 Dictionary 集合
+func (lkp *lookup) Dictionary() Dictionary {
+	var dict = newDictionary(lkp.t, len(lkp.groups))
+	for _, group := range lkp.groups {
+		var values = reflect.MakeSlice(lkp.t.Elem(), group.values.Len(), group.values.Len())
+		reflect.Copy(values, group.values)
+		dict.value.SetMapIndex(group.key, values)
+	}
+	return dict
+}
@@ -0,0 +1,63 @@
+package collections_test
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestLookup(t *testing.T) {
+	var lookup = slices.Number.ToLookup(func(n int) bool { return n%2 == 0 })
+	if lookup.Count() != 5 || lookup.Count(true) != 2 || lookup.Count(false) != 3 {
+		t.Fail()
+	}
+	if !reflect.DeepEqual(lookup.Get(false).Slice(), []int{1, 3, 5}) {
+		t.Fail()
+	}
+	lookup.Add(true, 6, 8)
+	if !lookup.RemoveValue(true, 2) || lookup.RemoveValue(true, 3) {
+		t.Fail()
+	}
+	if !reflect.DeepEqual(lookup.Dictionary().Map(), map[bool][]int{true: {4, 6, 8}, false: {1, 3, 5}}) {
+		t.Fail()
+	}
+	if !lookup.Remove(false) || lookup.Contains(false) || lookup.Keys().Count() != 1 {
+		t.Fail()
+	}
+}
+
+func TestLookupValueSelector(t *testing.T) {
+	var lookup = slices.Struct.ToLookup(func(i *Int) int { return i.Value % 2 }, func(i int, item *Int) string {
+		return strconv.Itoa(i) + ":" + item.Many[0]
+	})
+	if !reflect.DeepEqual(lookup.Get(1).Slice(), []string{"0:1", "2:3", "4:5"}) {
+		t.Fail()
+	}
+	var one = &Int{Value: 1}
+	var keyed = slices.Number.ToLookup(func(n int) interface{} {
+		if n%2 == 1 {
+			return one
+		}
+		return 0
+	})
+	if keyed.Count(1) != 3 || keyed.Keys().Count() != 2 {
+		t.Fail()
+	}
+	EstimateFail(t, func(*testing.T) {
+		slices.Number.ToLookup(func(s string) bool { return true })
+	})
+}
+
+func TestLookupRemoval(t *testing.T) {
+	var lookup = slices.Number.ToLookup(func(n int) int { return n })
+	if !lookup.Remove(2) || !lookup.RemoveValue(4, 4) || lookup.Remove(2) {
+		t.Fail()
+	}
+	lookup.Add(6, 6).Add(1, 7)
+	if !reflect.DeepEqual(lookup.Keys().Slice(), []int{1, 3, 5, 6}) || !reflect.DeepEqual(lookup.Get(1).Slice(), []int{1, 7}) {
+		t.Fail()
+	}
+	if lookup.Count(5) != 1 || lookup.Count(6) != 1 || lookup.Contains(4) {
+		t.Fail()
+	}
+}
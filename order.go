@@ -0,0 +1,96 @@
+package collections
+
+import (
+	"reflect"
+)
+
+//orderedLess 获取有序类型的自然比较函数（不属于有序类型时返回空函数）
+func orderedLess(t reflect.Type) func(a, b reflect.Value) bool {
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(a, b reflect.Value) bool { return a.Int() < b.Int() }
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return func(a, b reflect.Value) bool { return a.Uint() < b.Uint() }
+	case reflect.Float32, reflect.Float64:
+		return func(a, b reflect.Value) bool { return a.Float() < b.Float() }
+	case reflect.String:
+		return func(a, b reflect.Value) bool { return a.String() < b.String() }
+	}
+	return nil
+}
+
+//typeLess 获取 t1 类型的值与 t2 类型的值的比较函数（无法比较时返回空函数）
+//依次使用 CompareTo* 钩子（包括反向）、Less* 钩子、注册的排序比较函数与相同种类有序类型的自然顺序，接口类型在比较时按实际类型匹配。
+func typeLess(t1, t2 reflect.Type) func(a, b reflect.Value) bool {
+	if t1.Kind() == reflect.Interface || t2.Kind() == reflect.Interface {
+		return func(a, b reflect.Value) bool {
+			if a.Kind() == reflect.Interface {
+				a = a.Elem()
+			}
+			if b.Kind() == reflect.Interface {
+				b = b.Elem()
+			}
+			if !a.IsValid() || !b.IsValid() {
+				panic(throwMethodHasNoImplement("CompareTo", t1))
+			}
+			var less = typeLess(a.Type(), b.Type())
+			if less == nil {
+				panic(throwMethodHasNoImplement("CompareTo", a.Type()))
+			}
+			return less(a, b)
+		}
+	}
+	if function := getOrderHook(t1, t2); function != nil {
+		return func(a, b reflect.Value) bool { return call(*function, a, b)[0].Int() < 0 }
+	}
+	if function := getOrderHook(t2, t1); function != nil {
+		return func(a, b reflect.Value) bool { return call(*function, b, a)[0].Int() > 0 }
+	}
+	if function := getLessHook(t1, t2); function != nil {
+		return func(a, b reflect.Value) bool { return call(*function, a, b)[0].Bool() }
+	}
+	if function := getOrderComparer(t1, t2); function != nil {
+		return func(a, b reflect.Value) bool { return call(*function, a, b)[0].Int() < 0 }
+	}
+	if function := getOrderComparer(t2, t1); function != nil {
+		return func(a, b reflect.Value) bool { return call(*function, b, a)[0].Int() > 0 }
+	}
+	if less := orderedLess(t1); less != nil && t1.Kind() == t2.Kind() {
+		return func(a, b reflect.Value) bool { return less(a, b.Convert(t1)) }
+	}
+	return nil
+}
+
+//makeLess 根据比较函数或键选择函数构造元素比较函数，operator 为调用的操作名称
+//支持 func(T, T) bool 比较函数与 func(T) K 键选择函数（K 必须可比较大小），不给出时使用 T 的顺序。
+func makeLess(operator string, t reflect.Type, f ...interface{}) func(a, b reflect.Value) bool {
+	return makeLessWith(strictTypes(), operator, t, f...)
+}
+
+//makeLessWith 与 makeLess 相同，但使用给出的严格模式设置校验函数签名
+func makeLessWith(strict bool, operator string, t reflect.Type, f ...interface{}) func(a, b reflect.Value) bool {
+	if len(f) == 0 {
+		if less := typeLess(t, t); less != nil {
+			return less
+		}
+		panic(throwMethodHasNoImplement("less", t))
+	}
+	var function = lambdaWith(strict, operator, f[0],
+		//支持的函数签名
+		newFunc(t, t)(types.Bool)(),
+		newFunc(t)(types.AnyType)(),
+	)
+	if function.Type().NumIn() == 2 {
+		return func(a, b reflect.Value) bool {
+			return function.call(a, b)[0].Bool()
+		}
+	}
+	var kt = function.Type().Out(0)
+	var less = typeLess(kt, kt)
+	if less == nil {
+		panic(throwMethodHasNoImplement("less", kt))
+	}
+	return func(a, b reflect.Value) bool {
+		return less(function.call(a)[0], function.call(b)[0])
+	}
+}
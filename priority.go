@@ -0,0 +1,183 @@
+package collections
+
+import (
+	"container/heap"
+	"reflect"
+	"sort"
+)
+
+type (
+	Handle interface {
+		Value() interface{}
+	}
+
+	PriorityQueue interface {
+		Push(element interface{}) Handle
+		Pop() interface{}
+		TryPop() (interface{}, bool)
+		Peek() interface{}
+		Update(h Handle, element interface{})
+		Fix(h Handle)
+		Remove(h Handle) interface{}
+		Merge(pq PriorityQueue) PriorityQueue
+		Len() int
+		List() List
+		Strict(strict ...bool) PriorityQueue
+
+		Type() reflect.Type
+	}
+
+	priorityItem struct {
+		value reflect.Value
+		index int
+		owner *priorityQueue
+	}
+
+	//priorityQueue 基于二叉堆的优先队列，less 判定为真的元素优先出队
+	priorityQueue struct {
+		t     reflect.Type
+		items []*priorityItem
+		less  func(a, b reflect.Value) bool
+		strictness
+	}
+
+	//priorityHeap 用于实现 heap.Interface，避免与 PriorityQueue 的方法冲突
+	priorityHeap priorityQueue
+)
+
+func newPriorityQueue(t reflect.Type, less func(a, b reflect.Value) bool) *priorityQueue {
+	return &priorityQueue{t: t, less: less}
+}
+
+func (h *priorityHeap) Len() int {
+	return len(h.items)
+}
+
+func (h *priorityHeap) Less(i, j int) bool {
+	return h.less(h.items[i].value, h.items[j].value)
+}
+
+func (h *priorityHeap) Swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+	h.items[i].index, h.items[j].index = i, j
+}
+
+func (h *priorityHeap) Push(x interface{}) {
+	var item = x.(*priorityItem)
+	item.index = len(h.items)
+	h.items = append(h.items, item)
+}
+
+func (h *priorityHeap) Pop() interface{} {
+	var last = len(h.items) - 1
+	var item = h.items[last]
+	h.items[last], h.items = nil, h.items[:last]
+	item.index = -1
+	return item
+}
+
+func (item *priorityItem) Value() interface{} {
+	return item.value.Interface()
+}
+
+func (pq *priorityQueue) heap() *priorityHeap {
+	return (*priorityHeap)(pq)
+}
+
+//item 校验句柄属于当前队列且尚未出队
+func (pq *priorityQueue) item(h Handle) *priorityItem {
+	if item, ok := h.(*priorityItem); ok && item.owner == pq && item.index >= 0 {
+		return item
+	}
+	panic(throwHandleIsInvalid(pq.t))
+}
+
+func (pq *priorityQueue) Type() reflect.Type {
+	return pq.t
+}
+
+//Strict 为当前优先队列设置严格类型匹配模式（不给出参数时开启），影响写入的元素
+func (pq *priorityQueue) Strict(strict ...bool) PriorityQueue {
+	pq.setStrict(strict)
+	return pq
+}
+
+func (pq *priorityQueue) Len() int {
+	return len(pq.items)
+}
+
+//Push 插入元素并返回句柄，句柄可用于 Update、Fix 与 Remove
+func (pq *priorityQueue) Push(element interface{}) Handle {
+	var item = &priorityItem{value: pq.convertTo(element, pq.t.Elem()), owner: pq}
+	heap.Push(pq.heap(), item)
+	return item
+}
+
+func (pq *priorityQueue) Pop() interface{} {
+	if len(pq.items) == 0 {
+		panic(throwCollectionIsEmpty(pq.t))
+	}
+	return heap.Pop(pq.heap()).(*priorityItem).Value()
+}
+
+func (pq *priorityQueue) TryPop() (interface{}, bool) {
+	if len(pq.items) == 0 {
+		return nil, false
+	}
+	return pq.Pop(), true
+}
+
+func (pq *priorityQueue) Peek() interface{} {
+	if len(pq.items) == 0 {
+		panic(throwCollectionIsEmpty(pq.t))
+	}
+	return pq.items[0].Value()
+}
+
+//Update 替换句柄对应的元素并调整位置
+func (pq *priorityQueue) Update(h Handle, element interface{}) {
+	var item = pq.item(h)
+	item.value = pq.convertTo(element, pq.t.Elem())
+	heap.Fix(pq.heap(), item.index)
+}
+
+//Fix 在元素被外部修改（如指针元素的字段）后调整位置
+func (pq *priorityQueue) Fix(h Handle) {
+	heap.Fix(pq.heap(), pq.item(h).index)
+}
+
+//Remove 移除句柄对应的元素
+func (pq *priorityQueue) Remove(h Handle) interface{} {
+	return heap.Remove(pq.heap(), pq.item(h).index).(*priorityItem).Value()
+}
+
+//Merge 合并两个优先队列的元素到新的优先队列（使用当前队列的比较函数，原句柄不适用于新队列）
+func (pq *priorityQueue) Merge(other PriorityQueue) PriorityQueue {
+	if err := typeRequiredWith(pq.strictTypes(), other.Type(), pq.t); err != nil {
+		panic(err)
+	}
+	var newqueue = newPriorityQueue(pq.t, pq.less)
+	newqueue.strictness = pq.strictness
+	for _, items := range [][]*priorityItem{pq.items, other.(*priorityQueue).items} {
+		for _, item := range items {
+			newqueue.heap().Push(&priorityItem{value: item.value.Convert(pq.t.Elem()), owner: newqueue})
+		}
+	}
+	heap.Init(newqueue.heap())
+	return newqueue
+}
+
+//List 按出队顺序拷贝为 List 集合（不改变队列）
+func (pq *priorityQueue) List() List {
+	var values = make([]reflect.Value, len(pq.items))
+	for index, item := range pq.items {
+		values[index] = item.value
+	}
+	sort.SliceStable(values, func(i, j int) bool {
+		return pq.less(values[i], values[j])
+	})
+	var newlist = newList(pq.t)
+	newlist.strictness = pq.strictness
+	newlist.value.Set(reflect.Append(*newlist.value, values...))
+	return newlist
+}
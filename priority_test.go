@@ -0,0 +1,48 @@
+package collections_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/johnwiichang/collections"
+)
+
+func TestPriorityQueue(t *testing.T) {
+	var pq = collections.From([]int{5, 1, 4, 2}).List().ToPriorityQueue()
+	var handle = pq.Push(3)
+	if pq.Peek() != 1 || pq.Pop() != 1 || pq.Len() != 4 {
+		t.Fail()
+	}
+	pq.Update(handle, 0)
+	if pq.Pop() != 0 {
+		t.Fail()
+	}
+	EstimateFail(t, func(*testing.T) {
+		pq.Fix(handle)
+	})
+	var merged = pq.Merge(collections.From([]int{3, 1}).List().ToPriorityQueue())
+	if !reflect.DeepEqual(merged.List().Slice(), []int{1, 2, 3, 4, 5}) || pq.Len() != 3 {
+		t.Fail()
+	}
+	EstimateFail(t, func(*testing.T) {
+		pq.Merge(collections.From([]string{"1"}).List().ToPriorityQueue())
+	})
+}
+
+func TestPriorityQueueComparer(t *testing.T) {
+	var pq = slices.Struct.ToPriorityQueue(func(a, b *Int) bool { return a.Value > b.Value })
+	var top = pq.Peek().(*Int)
+	if top.Value != 5 {
+		t.Fail()
+	}
+	pq = slices.Struct.ToPriorityQueue(func(i *Int) int { return i.Value })
+	var handle = pq.Push(&Int{Value: 6})
+	handle.Value().(*Int).Value = -1
+	pq.Fix(handle)
+	if pq.Pop().(*Int).Value != -1 {
+		t.Fail()
+	}
+	EstimateFail(t, func(*testing.T) {
+		slices.Struct.ToPriorityQueue()
+	})
+}
@@ -0,0 +1,112 @@
+package collections
+
+import (
+	"bytes"
+	"math/big"
+	"net"
+	"reflect"
+	"sync"
+	"time"
+)
+
+var (
+	equalityComparers = &sync.Map{}
+	orderComparers    = &sync.Map{}
+	hashers           = &sync.Map{}
+)
+
+func init() {
+	RegisterEqualityComparer(func(a, b time.Time) bool { return a.Equal(b) })
+	RegisterEqualityComparer(bytes.Equal)
+	RegisterEqualityComparer(func(a, b net.IP) bool { return a.Equal(b) })
+	RegisterEqualityComparer(func(a, b *big.Int) bool { return a.Cmp(b) == 0 })
+	RegisterEqualityComparer(func(a, b *big.Float) bool { return a.Cmp(b) == 0 })
+	RegisterEqualityComparer(func(a, b *big.Rat) bool { return a.Cmp(b) == 0 })
+
+	RegisterOrderComparer(func(a, b time.Time) int {
+		if a.Before(b) {
+			return -1
+		} else if a.After(b) {
+			return 1
+		}
+		return 0
+	})
+	RegisterOrderComparer(bytes.Compare)
+	RegisterOrderComparer(func(a, b net.IP) int { return bytes.Compare(a.To16(), b.To16()) })
+	RegisterOrderComparer((*big.Int).Cmp)
+	RegisterOrderComparer((*big.Float).Cmp)
+	RegisterOrderComparer((*big.Rat).Cmp)
+
+	RegisterHasher(func(t time.Time) uint64 { return uint64(t.UnixNano()) })
+	RegisterHasher(func(n *big.Int) uint64 { return hashValue(reflect.ValueOf(n.Text(16))) })
+	RegisterHasher(func(n *big.Float) uint64 {
+		//二进制指数形式精确且与精度无关，+0 与 -0 相等
+		if n == nil || n.Sign() == 0 {
+			return 0
+		}
+		return hashValue(reflect.ValueOf(n.Text('p', 0)))
+	})
+	RegisterHasher(func(n *big.Rat) uint64 {
+		if n == nil {
+			return 0
+		}
+		return hashValue(reflect.ValueOf(n.RatString()))
+	})
+}
+
+//register 写入注册表，返回撤销本次注册（恢复此前的注册）的函数
+func register(registry *sync.Map, key interface{}, function *reflect.Value) func() {
+	var previous, existed = registry.Load(key)
+	registry.Store(key, function)
+	return func() {
+		if existed {
+			registry.Store(key, previous)
+		} else {
+			registry.Delete(key)
+		}
+	}
+}
+
+//RegisterEqualityComparer 为无法添加 EqualsTo* 方法的类型注册相等比较函数 func(T1, T2) bool
+//注册的函数在 EqualsTo* 钩子之后、== 之前使用，并对其结果直接采信。返回的函数用于撤销注册（例如在测试中 defer 调用）。
+func RegisterEqualityComparer(f interface{}) func() {
+	var function = lambda("RegisterEqualityComparer", f, newFunc(types.AnyType, types.AnyType)(types.Bool)()).Value
+	var t = function.Type()
+	return register(equalityComparers, [2]reflect.Type{t.In(0), t.In(1)}, &function)
+}
+
+//RegisterOrderComparer 为无法添加 CompareTo* 方法的类型注册排序比较函数 func(T1, T2) int
+//小于、等于、大于分别返回负数、零、正数，注册的函数在 CompareTo*、Less* 钩子之后、自然顺序之前使用。返回的函数用于撤销注册。
+func RegisterOrderComparer(f interface{}) func() {
+	var function = lambda("RegisterOrderComparer", f, newFunc(types.AnyType, types.AnyType)(types.Int)()).Value
+	var t = function.Type()
+	return register(orderComparers, [2]reflect.Type{t.In(0), t.In(1)}, &function)
+}
+
+//RegisterHasher 注册哈希函数 func(T) uint64，相等（包括注册的相等比较函数）的值必须得到相同的哈希
+//返回的函数用于撤销注册。
+func RegisterHasher(f interface{}) func() {
+	var function = lambda("RegisterHasher", f, newFunc(types.AnyType)(reflect.TypeOf(uint64(0)))()).Value
+	return register(hashers, function.Type().In(0), &function)
+}
+
+func getEqualityComparer(t1, t2 reflect.Type) *reflect.Value {
+	if function, existed := equalityComparers.Load([2]reflect.Type{t1, t2}); existed {
+		return function.(*reflect.Value)
+	}
+	return nil
+}
+
+func getOrderComparer(t1, t2 reflect.Type) *reflect.Value {
+	if function, existed := orderComparers.Load([2]reflect.Type{t1, t2}); existed {
+		return function.(*reflect.Value)
+	}
+	return nil
+}
+
+func getHasher(t reflect.Type) *reflect.Value {
+	if function, existed := hashers.Load(t); existed {
+		return function.(*reflect.Value)
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package collections_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/johnwiichang/collections"
+)
+
+type Celsius struct {
+	Degree float64
+	Source string
+}
+
+func TestRegistry(t *testing.T) {
+	var now = time.Now()
+	var times = []time.Time{now, now.In(time.UTC), now.Add(-time.Hour), now.Round(0)}
+	if collections.From(times).List().Distinct().Count() != 2 {
+		t.Fail()
+	}
+	if !collections.From(times).List().Contains(now.In(time.FixedZone("X", 3600))) {
+		t.Fail()
+	}
+	var earliest = collections.From(times).List().Min().(time.Time)
+	if !earliest.Equal(now.Add(-time.Hour)) {
+		t.Fail()
+	}
+	var blobs = [][]byte{[]byte("a"), []byte("b"), []byte("a")}
+	if collections.From(blobs).List().Distinct().Count() != 2 || !collections.From(blobs).List().Any([]byte("b")) {
+		t.Fail()
+	}
+	defer collections.RegisterEqualityComparer(func(a, b Celsius) bool { return a.Degree == b.Degree })()
+	defer collections.RegisterOrderComparer(func(a, b Celsius) int { return int(a.Degree - b.Degree) })()
+	defer collections.RegisterHasher(func(c Celsius) uint64 { return uint64(c.Degree) })()
+	var degrees = collections.From([]Celsius{{20, "a"}, {18, "b"}, {20, "c"}, {25, "d"}}).List()
+	if degrees.Distinct().Count() != 3 || !degrees.Contains(Celsius{Degree: 18}) {
+		t.Fail()
+	}
+	if degrees.Max().(Celsius).Source != "d" || degrees.Min().(Celsius).Source != "b" {
+		t.Fail()
+	}
+	var dict = collections.From(map[Celsius]int{}).Dictionary().ToImmutable().Set(Celsius{20, "a"}, 1).Set(Celsius{20, "b"}, 2)
+	if dict.Len() != 1 {
+		t.Fail()
+	}
+	EstimateFail(t, func(t *testing.T) {
+		collections.RegisterEqualityComparer(func(a, b Celsius) int { return 0 })
+	})
+}
+
+func TestUnregister(t *testing.T) {
+	var readings = collections.From([]Celsius{{20, "a"}, {20, "b"}}).List()
+	var unregister = collections.RegisterEqualityComparer(func(a, b Celsius) bool { return a.Degree == b.Degree })
+	if readings.Distinct().Count() != 1 {
+		t.Fail()
+	}
+	unregister()
+	if readings.Distinct().Count() != 2 {
+		t.Fail()
+	}
+	var restore = collections.RegisterEqualityComparer(func(a, b time.Time) bool { return false })
+	restore()
+	var now = time.Now()
+	if !collections.From([]time.Time{now}).List().Contains(now.In(time.UTC)) {
+		t.Fail()
+	}
+}
@@ -0,0 +1,94 @@
+package collections
+
+import (
+	"reflect"
+)
+
+const (
+	//Overwrite 缓冲区已满时覆盖最旧的元素
+	Overwrite RingPolicy = iota
+	//Reject 缓冲区已满时拒绝写入
+	Reject
+)
+
+type (
+	RingPolicy int
+
+	RingBuffer interface {
+		Push(elements ...interface{}) RingBuffer
+		TryPush(element interface{}) bool
+		PopOldest() interface{}
+		TryPopOldest() (interface{}, bool)
+		Len() int
+		Cap() int
+		Full() bool
+		Strict(strict ...bool) RingBuffer
+		Iterator() Iterator
+		List() List
+
+		Type() reflect.Type
+	}
+
+	//ringBuffer 固定容量的环形缓冲区，复用双端队列的环形存储
+	ringBuffer struct {
+		*deque
+		policy RingPolicy
+	}
+)
+
+func newRingBuffer(t reflect.Type, capacity int, policy RingPolicy) *ringBuffer {
+	if capacity <= 0 {
+		panic(throwArgumentIsInvalid("capacity", capacity))
+	}
+	var d = newDeque(t, capacity)
+	d.buffer = reflect.MakeSlice(t, capacity, capacity)
+	return &ringBuffer{deque: d, policy: policy}
+}
+
+func (rb *ringBuffer) push(value reflect.Value) bool {
+	if rb.full() {
+		if rb.policy == Reject {
+			return false
+		}
+		rb.popFront()
+	}
+	rb.pushBack(value)
+	return true
+}
+
+func (rb *ringBuffer) Cap() int {
+	return rb.bound
+}
+
+func (rb *ringBuffer) Full() bool {
+	return rb.full()
+}
+
+func (rb *ringBuffer) Strict(strict ...bool) RingBuffer {
+	rb.setStrict(strict)
+	return rb
+}
+
+//Push 写入元素
+//Reject 策略下缓冲区已满时会抛出 panic 异常。
+func (rb *ringBuffer) Push(elements ...interface{}) RingBuffer {
+	for _, element := range elements {
+		if !rb.push(rb.convertTo(element, rb.t.Elem())) {
+			panic(throwCollectionIsFull(rb.t, rb.bound))
+		}
+	}
+	return rb
+}
+
+//TryPush 写入元素，Reject 策略下缓冲区已满时返回 false
+func (rb *ringBuffer) TryPush(element interface{}) bool {
+	return rb.push(rb.convertTo(element, rb.t.Elem()))
+}
+
+func (rb *ringBuffer) PopOldest() interface{} {
+	return rb.PopFront()
+}
+
+func (rb *ringBuffer) TryPopOldest() (interface{}, bool) {
+	return rb.TryPopFront()
+}
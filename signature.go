@@ -0,0 +1,245 @@
+package collections
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+type (
+	//Signature 函数签名构造器，输入输出可以使用 Any（任意单个类型）与 AnyRest（往后任意数量任意类型）通配
+	Signature interface {
+		In(in ...reflect.Type) Signature
+		Out(out ...reflect.Type) Signature
+		Variadic() Signature
+		Type() reflect.Type
+		String() string
+	}
+
+	signature struct {
+		in, out  []reflect.Type
+		variadic bool
+	}
+
+	//customSignature 为操作注册的额外签名与适配函数
+	customSignature struct {
+		t     reflect.Type
+		adapt func(reflect.Value) reflect.Value
+	}
+
+	//lambdaFunc 经过签名校验的操作函数，记录所属的操作
+	lambdaFunc struct {
+		reflect.Value
+		operator string
+	}
+
+	//matchedKey 签名匹配结果的缓存键（候选签名最多 4 个时缓存）
+	matchedKey struct {
+		operator string
+		target   reflect.Type
+		strict   bool
+		estimate [4]reflect.Type
+	}
+)
+
+var (
+	//Any 匹配任意单个类型的通配
+	Any = types.AnyType
+	//AnyRest 匹配往后任意数量任意类型的通配
+	AnyRest = types.AnyTypes
+
+	strict int32
+
+	//matchedSignatures 已匹配内置签名的函数类型
+	matchedSignatures = &sync.Map{}
+
+	customSignatures = struct {
+		sync.RWMutex
+		operators map[string][]*customSignature
+	}{operators: map[string][]*customSignature{}}
+)
+
+//SetStrictTypes 设置全局严格类型匹配模式，返回此前的设置
+//严格模式下函数参数、返回值与传入的对象只接受相同或可赋值的类型，不再进行可能丢失信息的类型转换（如 float64 到 int、int 到 string）。
+func SetStrictTypes(enabled bool) bool {
+	var value int32
+	if enabled {
+		value = 1
+	}
+	return atomic.SwapInt32(&strict, value) == 1
+}
+
+func strictTypes() bool {
+	return atomic.LoadInt32(&strict) == 1
+}
+
+//strictness 集合的严格类型匹配设置，未设置时使用全局设置
+type strictness struct {
+	strict *bool
+}
+
+//setStrict 设置严格类型匹配模式（不给出参数时开启）
+func (s *strictness) setStrict(strict []bool) {
+	var value = append(strict, true)[0]
+	s.strict = &value
+}
+
+func (s *strictness) strictTypes() bool {
+	if s.strict != nil {
+		return *s.strict
+	}
+	return strictTypes()
+}
+
+//lambda 使用集合的严格模式设置校验操作的函数参数
+func (s *strictness) lambda(operator string, f interface{}, estimate ...reflect.Type) lambdaFunc {
+	return lambdaWith(s.strictTypes(), operator, f, estimate...)
+}
+
+//convertTo 使用集合的严格模式设置转换对象
+func (s *strictness) convertTo(obj interface{}, t reflect.Type) reflect.Value {
+	return convertToWith(s.strictTypes(), obj, t)
+}
+
+//NewSignature 创建空的函数签名 func()
+func NewSignature() Signature {
+	return &signature{}
+}
+
+func (s *signature) In(in ...reflect.Type) Signature {
+	s.in = append(s.in, in...)
+	return s
+}
+
+func (s *signature) Out(out ...reflect.Type) Signature {
+	s.out = append(s.out, out...)
+	return s
+}
+
+//Variadic 将最后一个输入（必须为切片）标记为可变参数
+//没有输入或最后一个输入不是切片时抛出 TypeNotCompatible 异常。
+func (s *signature) Variadic() Signature {
+	s.variadic = true
+	s.check()
+	return s
+}
+
+func (s *signature) Type() reflect.Type {
+	s.check()
+	return newFunc(s.in...)(s.out...)(s.variadic)
+}
+
+//check 校验可变参数签名的最后一个输入为切片
+func (s *signature) check() {
+	if !s.variadic {
+		return
+	}
+	if len(s.in) == 0 {
+		panic(throwTypeNotCompatiable("[]T", nil))
+	}
+	if last := s.in[len(s.in)-1]; last.Kind() != reflect.Slice {
+		panic(throwTypeNotCompatiable("[]T", last))
+	}
+}
+
+func (s *signature) String() string {
+	return typeName(s.Type())
+}
+
+//Matches 判断函数是否匹配给出的任一签名，不匹配时返回 TypeNotCompatible 错误
+func Matches(fn interface{}, sigs ...Signature) error {
+	var estimate = make([]reflect.Type, len(sigs))
+	for i, sig := range sigs {
+		estimate[i] = sig.Type()
+	}
+	if fn == nil {
+		return throwTypeNotCompatiable(joinTypeNames(estimate), nil)
+	}
+	return typeRequired(reflect.TypeOf(fn), estimate...)
+}
+
+//RegisterSignature 为操作（如 List.Select、Dictionary.Where）注册额外接受的函数签名
+//函数不匹配操作的内置签名但匹配注册的签名时，会先经 adapt 转换为内置签名之一的函数再使用。返回的函数用于撤销注册。
+func RegisterSignature(operator string, sig Signature, adapt func(fn reflect.Value) reflect.Value) func() {
+	var custom = &customSignature{t: sig.Type(), adapt: adapt}
+	customSignatures.Lock()
+	defer customSignatures.Unlock()
+	customSignatures.operators[operator] = append(customSignatures.operators[operator], custom)
+	return func() {
+		customSignatures.Lock()
+		defer customSignatures.Unlock()
+		var customs = customSignatures.operators[operator]
+		for i := range customs {
+			if customs[i] == custom {
+				customSignatures.operators[operator] = append(customs[:i:i], customs[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+//lambda 校验操作的函数参数并返回函数值（使用全局严格模式设置）
+func lambda(operator string, f interface{}, estimate ...reflect.Type) lambdaFunc {
+	return lambdaWith(strictTypes(), operator, f, estimate...)
+}
+
+//lambdaWith 校验操作的函数参数并返回函数值
+//不匹配内置签名时尝试使用为该操作注册的签名进行适配，仍不匹配则抛出 panic 异常。
+func lambdaWith(strict bool, operator string, f interface{}, estimate ...reflect.Type) lambdaFunc {
+	var function = reflect.ValueOf(f)
+	if function.Kind() != reflect.Func || function.IsNil() {
+		var err = throwTypeNotCompatiable(joinTypeNames(estimate), nil)
+		err.(*TypeNotCompatible).Operator = operator
+		panic(err)
+	}
+	var key = matchedKey{operator: operator, target: function.Type(), strict: strict}
+	var cacheable = len(estimate) <= len(key.estimate)
+	if cacheable {
+		copy(key.estimate[:], estimate)
+		if _, matched := matchedSignatures.Load(key); matched {
+			return lambdaFunc{Value: function, operator: operator}
+		}
+	}
+	var err = typeRequiredWith(strict, function.Type(), estimate...)
+	if err == nil && cacheable {
+		matchedSignatures.Store(key, struct{}{})
+	} else if err != nil {
+		customSignatures.RLock()
+		var customs = customSignatures.operators[operator]
+		customSignatures.RUnlock()
+		for _, custom := range customs {
+			if typeCompare(function.Type(), custom.t, strict) != compareResults.NotMatch {
+				function = custom.adapt(function)
+				err = typeRequiredWith(strict, function.Type(), estimate...)
+				break
+			}
+		}
+	}
+	if err != nil {
+		err.(*TypeNotCompatible).Operator = operator
+		panic(err)
+	}
+	return lambdaFunc{Value: function, operator: operator}
+}
+
+//call 调用操作函数，函数发生的 panic 会包装为记录了操作名称的 LambdaPanicked
+func (lf lambdaFunc) call(in ...reflect.Value) []reflect.Value {
+	return invoke(lf.operator, lf.Value, in...)
+}
+
+//typeName 类型名称，通配类型显示为 any 与 ...any
+func typeName(t reflect.Type) string {
+	return strings.NewReplacer(
+		types.AnyTypes.String(), "...any",
+		types.AnyType.String(), "any",
+	).Replace(t.String())
+}
+
+func joinTypeNames(estimate []reflect.Type) string {
+	var names = make([]string, len(estimate))
+	for i, t := range estimate {
+		names[i] = typeName(t)
+	}
+	return strings.Join(names, "', '")
+}
@@ -0,0 +1,198 @@
+package collections_test
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/johnwiichang/collections"
+)
+
+func TestSignature(t *testing.T) {
+	var str, boolean = reflect.TypeOf(""), reflect.TypeOf(true)
+	var predicate = collections.NewSignature().In(str).Out(boolean)
+	var callback = collections.NewSignature().In(collections.Any, collections.AnyRest)
+	if collections.Matches(strings.HasPrefix, predicate) == nil || collections.Matches(strings.HasPrefix, predicate, callback) != nil {
+		t.Fail()
+	}
+	if collections.Matches(func(s string) bool { return s == "" }, predicate) != nil {
+		t.Fail()
+	}
+	var err = collections.Matches(func() {}, predicate, callback)
+	if tnc, ok := err.(*collections.TypeNotCompatible); !ok || !strings.Contains(tnc.Error(), "func(string) bool', 'func(any, ...any)") {
+		t.Fail()
+	}
+	if collections.Matches(nil, predicate) == nil {
+		t.Fail()
+	}
+	if collections.NewSignature().In(reflect.TypeOf([]int{})).Variadic().String() != "func(...int)" {
+		t.Fail()
+	}
+	err = recoverError(func() {
+		collections.NewSignature().In(str).Variadic()
+	})
+	if !errors.Is(err, collections.ErrTypeNotCompatible) {
+		t.Fail()
+	}
+	err = recoverError(func() {
+		collections.NewSignature().Variadic()
+	})
+	if !errors.Is(err, collections.ErrTypeNotCompatible) || err.Error() == "" {
+		t.Fail()
+	}
+}
+
+//TestExtraInputs 多余的输入不会在调用时得到参数，因此不再匹配（以 AnyRest 结尾的签名除外）
+func TestExtraInputs(t *testing.T) {
+	var predicate = collections.NewSignature().In(reflect.TypeOf("")).Out(reflect.TypeOf(true))
+	if collections.Matches(func(s, prefix string) bool { return false }, predicate) == nil {
+		t.Fail()
+	}
+	if collections.Matches(func(s, prefix string) bool { return false }, predicate.In(collections.AnyRest)) != nil {
+		t.Fail()
+	}
+	var tnc *collections.TypeNotCompatible
+	var err = recoverError(func() {
+		collections.From([]string{"a"}).List().Where(func(s, prefix string) bool { return strings.HasPrefix(s, prefix) })
+	})
+	if !errors.As(err, &tnc) || tnc.Operator != "List.Where" {
+		t.Fail()
+	}
+}
+
+func TestRegisterSignature(t *testing.T) {
+	var integer, boolean = reflect.TypeOf(0), reflect.TypeOf(true)
+	var unregister = collections.RegisterSignature("List.Where", collections.NewSignature().In(collections.Any).Out(integer), func(fn reflect.Value) reflect.Value {
+		return reflect.MakeFunc(reflect.FuncOf([]reflect.Type{fn.Type().In(0)}, []reflect.Type{boolean}, false), func(args []reflect.Value) []reflect.Value {
+			return []reflect.Value{reflect.ValueOf(fn.Call(args)[0].Int() != 0)}
+		})
+	})
+	var odd = func(x int) int { return x & 1 }
+	if collections.From([]int{1, 2, 3, 4, 5}).List().Where(odd).Count() != 3 {
+		t.Fail()
+	}
+	EstimateFail(t, func(t *testing.T) {
+		collections.From([]int{1}).List().Where(func(x int) string { return "" })
+	})
+	unregister()
+	EstimateFail(t, func(t *testing.T) {
+		collections.From([]int{1, 2, 3, 4, 5}).List().Where(odd)
+	})
+}
+
+type Celsius64 float64
+
+func TestStrictTypes(t *testing.T) {
+	var degrees = []float64{20.5, 18.2}
+	var round = func(c Celsius64) int { return int(c) }
+	if collections.From(degrees).List().Select(round).Slice().([]int)[1] != 18 {
+		t.Fail()
+	}
+	EstimateFail(t, func(t *testing.T) {
+		collections.From(degrees).List().Strict().Select(round)
+	})
+	var strict = collections.From(degrees).List().Strict().Where(func(x float64) bool { return x > 19 })
+	EstimateFail(t, func(t *testing.T) {
+		strict.ForEach(func(c Celsius64) {})
+	})
+	strict.Strict(false).ForEach(func(c Celsius64) {})
+	defer collections.SetStrictTypes(collections.SetStrictTypes(true))
+	EstimateFail(t, func(t *testing.T) {
+		collections.From(degrees).List().Select(round)
+	})
+	EstimateFail(t, func(t *testing.T) {
+		collections.From([]string{"a"}).List().ToLinkedList().PushBack(65)
+	})
+	collections.From(degrees).List().Strict(false).Select(round)
+	defer func() {
+		var err, ok = recover().(*collections.TypeNotCompatible)
+		if !ok || err.Parameter != 1 || err.Expected != reflect.TypeOf(0.0) {
+			t.Fail()
+		}
+	}()
+	collections.From(degrees).List().ForEach(func(i int, c Celsius64) {})
+}
+
+func TestInterfaceParameters(t *testing.T) {
+	var numbers = collections.From([]int{1}).List()
+	for _, list := range []collections.List{numbers, numbers.Strict()} {
+		var err = recoverError(func() { list.Where(func(s fmt.Stringer) bool { return true }) })
+		if !errors.Is(err, collections.ErrTypeNotCompatible) {
+			t.Fail()
+		}
+	}
+	if numbers.Where(func(v interface{}) bool { return v == 1 }).Count() != 1 {
+		t.Fail()
+	}
+	var stamps = collections.From([]time.Duration{time.Second}).List().Strict()
+	if stamps.Select(func(s fmt.Stringer) string { return s.String() }).Slice().([]string)[0] != "1s" {
+		t.Fail()
+	}
+}
+
+func TestContainerStrictTypes(t *testing.T) {
+	var deque = collections.From([]int{}).Deque()
+	if deque.PushBack(2.0, int64(3)).PopFront() != 2 {
+		t.Fail()
+	}
+	EstimateFail(t, func(t *testing.T) {
+		deque.PushBack(1.9)
+	})
+	EstimateFail(t, func(t *testing.T) {
+		collections.From([]int{}).Queue().Push(1e20)
+	})
+	EstimateFail(t, func(t *testing.T) {
+		collections.From([]int{}).List().Strict().ToLinkedList().PushBack(int64(1))
+	})
+	EstimateFail(t, func(t *testing.T) {
+		collections.From(map[int]int{}).Cache(2).Strict().Set(int64(1), 1)
+	})
+	EstimateFail(t, func(t *testing.T) {
+		collections.From([]int{}).RingBuffer(2).Strict().Push(int64(1))
+	})
+	for _, push := range []func(){
+		func() { collections.From([]int8{}).Deque().PushBack(300) },
+		func() { collections.From([]uint{}).Deque().PushBack(-1) },
+		func() { collections.From([]string{}).Deque().PushBack(65) },
+	} {
+		if !errors.Is(recoverError(push), collections.ErrTypeNotCompatible) {
+			t.Fail()
+		}
+	}
+	if collections.From([]int8{}).Deque().PushBack(int64(-128), 127.0).Len() != 2 {
+		t.Fail()
+	}
+	var celsius = func(c Celsius64) bool { return c > 0 }
+	EstimateFail(t, func(t *testing.T) {
+		collections.From([]float64{1}).Stack().Strict().List().Where(celsius)
+	})
+	EstimateFail(t, func(t *testing.T) {
+		collections.From(map[string]float64{"a": 1}).Dictionary().Strict().Where(func(k string, v Celsius64) bool { return true })
+	})
+	EstimateFail(t, func(t *testing.T) {
+		collections.From(map[int]int{}).SortedDictionary().Strict().(collections.SortedDictionary).Set(int64(1), 1)
+	})
+	EstimateFail(t, func(t *testing.T) {
+		collections.From([]int{}).List().ToPriorityQueue().Strict().Push(int64(1))
+	})
+	var sorted = collections.From(map[string]float64{"a": 1}).SortedDictionary().Strict()
+	EstimateFail(t, func(t *testing.T) {
+		sorted.Where(func(k string) bool { return true }).Values().Where(celsius)
+	})
+	if collections.From(map[string]float64{"a": 1}).Dictionary().Where(func(k string, v Celsius64) bool { return v > 0 }).Count() != 1 {
+		t.Fail()
+	}
+	defer collections.SetStrictTypes(collections.SetStrictTypes(true))
+	collections.From([]int{}).Stack().Strict(false).Push(int64(1))
+	var linked = collections.From([]int{1}).List().Strict(false).ToLinkedList()
+	linked.Front().Set(int64(2))
+	if linked.Where(func(n int) bool { return n == 2 }).Len() != 1 {
+		t.Fail()
+	}
+	EstimateFail(t, func(t *testing.T) {
+		collections.From([]int{}).Deque().PushBack(int64(1))
+	})
+}
@@ -0,0 +1,518 @@
+package collections
+
+import (
+	"reflect"
+)
+
+type (
+	SortedDictionary interface {
+		Dictionary
+
+		Get(key interface{}) (interface{}, bool)
+		Set(key, value interface{}) SortedDictionary
+		Remove(key interface{}) bool
+		Range(lo, hi interface{}) SortedDictionary
+		Floor(key interface{}) (interface{}, bool)
+		Ceiling(key interface{}) (interface{}, bool)
+		Min() (interface{}, bool)
+		Max() (interface{}, bool)
+		Rank(key interface{}) int
+		Iterator() Iterator
+	}
+
+	//rbnode 左倾红黑树节点，size 为子树节点数量（用于 Rank）
+	rbnode struct {
+		key, value  reflect.Value
+		left, right *rbnode
+		red         bool
+		size        int
+	}
+
+	//sortedDictionary 基于左倾红黑树的有序映射集合
+	sortedDictionary struct {
+		t    reflect.Type
+		root *rbnode
+		less func(a, b reflect.Value) bool
+		strictness
+	}
+)
+
+func newSortedDictionary(t reflect.Type, less func(a, b reflect.Value) bool) *sortedDictionary {
+	return &sortedDictionary{t: t, less: less}
+}
+
+//derive 创建使用相同比较函数并继承当前设置的新有序映射集合
+func (sd *sortedDictionary) derive() *sortedDictionary {
+	var newmap = newSortedDictionary(sd.t, sd.less)
+	newmap.strictness = sd.strictness
+	return newmap
+}
+
+//Strict 为当前有序映射集合设置严格类型匹配模式（不给出参数时开启），由其产生的集合会继承该设置
+//返回值为 Dictionary，可以断言为 SortedDictionary 继续使用。
+func (sd *sortedDictionary) Strict(strict ...bool) Dictionary {
+	sd.setStrict(strict)
+	return sd
+}
+
+func isRed(n *rbnode) bool {
+	return n != nil && n.red
+}
+
+func sizeOf(n *rbnode) int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+func rotateLeft(h *rbnode) *rbnode {
+	var x = h.right
+	h.right, x.left = x.left, h
+	x.red, h.red = h.red, true
+	x.size, h.size = h.size, 1+sizeOf(h.left)+sizeOf(h.right)
+	return x
+}
+
+func rotateRight(h *rbnode) *rbnode {
+	var x = h.left
+	h.left, x.right = x.right, h
+	x.red, h.red = h.red, true
+	x.size, h.size = h.size, 1+sizeOf(h.left)+sizeOf(h.right)
+	return x
+}
+
+func flipColors(h *rbnode) {
+	h.red, h.left.red, h.right.red = !h.red, !h.left.red, !h.right.red
+}
+
+func balance(h *rbnode) *rbnode {
+	if isRed(h.right) && !isRed(h.left) {
+		h = rotateLeft(h)
+	}
+	if isRed(h.left) && isRed(h.left.left) {
+		h = rotateRight(h)
+	}
+	if isRed(h.left) && isRed(h.right) {
+		flipColors(h)
+	}
+	h.size = 1 + sizeOf(h.left) + sizeOf(h.right)
+	return h
+}
+
+func moveRedLeft(h *rbnode) *rbnode {
+	flipColors(h)
+	if isRed(h.right.left) {
+		h.right = rotateRight(h.right)
+		h = rotateLeft(h)
+		flipColors(h)
+	}
+	return h
+}
+
+func moveRedRight(h *rbnode) *rbnode {
+	flipColors(h)
+	if isRed(h.left.left) {
+		h = rotateRight(h)
+		flipColors(h)
+	}
+	return h
+}
+
+func deleteMin(h *rbnode) *rbnode {
+	if h.left == nil {
+		return nil
+	}
+	if !isRed(h.left) && !isRed(h.left.left) {
+		h = moveRedLeft(h)
+	}
+	h.left = deleteMin(h.left)
+	return balance(h)
+}
+
+func (sd *sortedDictionary) equal(a, b reflect.Value) bool {
+	return !sd.less(a, b) && !sd.less(b, a)
+}
+
+func (sd *sortedDictionary) put(h *rbnode, key, value reflect.Value) *rbnode {
+	if h == nil {
+		return &rbnode{key: key, value: value, red: true, size: 1}
+	}
+	if sd.less(key, h.key) {
+		h.left = sd.put(h.left, key, value)
+	} else if sd.less(h.key, key) {
+		h.right = sd.put(h.right, key, value)
+	} else {
+		h.value = value
+	}
+	return balance(h)
+}
+
+func (sd *sortedDictionary) delete(h *rbnode, key reflect.Value) *rbnode {
+	if sd.less(key, h.key) {
+		if !isRed(h.left) && !isRed(h.left.left) {
+			h = moveRedLeft(h)
+		}
+		h.left = sd.delete(h.left, key)
+	} else {
+		if isRed(h.left) {
+			h = rotateRight(h)
+		}
+		if sd.equal(key, h.key) && h.right == nil {
+			return nil
+		}
+		if !isRed(h.right) && !isRed(h.right.left) {
+			h = moveRedRight(h)
+		}
+		if sd.equal(key, h.key) {
+			var min = h.right
+			for min.left != nil {
+				min = min.left
+			}
+			h.key, h.value = min.key, min.value
+			h.right = deleteMin(h.right)
+		} else {
+			h.right = sd.delete(h.right, key)
+		}
+	}
+	return balance(h)
+}
+
+func (sd *sortedDictionary) find(key reflect.Value) *rbnode {
+	var n = sd.root
+	for n != nil {
+		if sd.less(key, n.key) {
+			n = n.left
+		} else if sd.less(n.key, key) {
+			n = n.right
+		} else {
+			return n
+		}
+	}
+	return nil
+}
+
+func (sd *sortedDictionary) set(key, value reflect.Value) {
+	sd.root = sd.put(sd.root, key, value)
+	sd.root.red = false
+}
+
+//nodes 按键的顺序获取全部节点
+func (sd *sortedDictionary) nodes() []*rbnode {
+	var nodes, stack = make([]*rbnode, 0, sizeOf(sd.root)), []*rbnode{}
+	for n := sd.root; n != nil || len(stack) > 0; n = n.right {
+		for ; n != nil; n = n.left {
+			stack = append(stack, n)
+		}
+		n, stack = stack[len(stack)-1], stack[:len(stack)-1]
+		nodes = append(nodes, n)
+	}
+	return nodes
+}
+
+func (sd *sortedDictionary) Type() reflect.Type {
+	return sd.t
+}
+
+func (sd *sortedDictionary) Get(key interface{}) (interface{}, bool) {
+	if n := sd.find(sd.convertTo(key, sd.t.Key())); n != nil {
+		return n.value.Interface(), true
+	}
+	return nil, false
+}
+
+func (sd *sortedDictionary) Set(key, value interface{}) SortedDictionary {
+	sd.set(sd.convertTo(key, sd.t.Key()), sd.convertTo(value, sd.t.Elem()))
+	return sd
+}
+
+func (sd *sortedDictionary) Remove(key interface{}) bool {
+	var k = sd.convertTo(key, sd.t.Key())
+	if sd.find(k) == nil {
+		return false
+	}
+	if !isRed(sd.root.left) && !isRed(sd.root.right) {
+		sd.root.red = true
+	}
+	if sd.root = sd.delete(sd.root, k); sd.root != nil {
+		sd.root.red = false
+	}
+	return true
+}
+
+//Range 获取键位于 [lo, hi] 闭区间内的元素
+//中序遍历时跳过区间外的子树，只访问 O(log n + k) 个节点。
+func (sd *sortedDictionary) Range(lo, hi interface{}) SortedDictionary {
+	var low, high = sd.convertTo(lo, sd.t.Key()), sd.convertTo(hi, sd.t.Key())
+	var newmap, stack = sd.derive(), []*rbnode{}
+	for n := sd.root; n != nil || len(stack) > 0; n = n.right {
+		for n != nil {
+			if sd.less(n.key, low) {
+				n = n.right
+			} else {
+				stack, n = append(stack, n), n.left
+			}
+		}
+		if len(stack) == 0 {
+			break
+		}
+		n, stack = stack[len(stack)-1], stack[:len(stack)-1]
+		if sd.less(high, n.key) {
+			break
+		}
+		newmap.set(n.key, n.value)
+	}
+	return newmap
+}
+
+//Floor 获取小于等于给定键的最大键
+func (sd *sortedDictionary) Floor(key interface{}) (interface{}, bool) {
+	var k, best = sd.convertTo(key, sd.t.Key()), (*rbnode)(nil)
+	for n := sd.root; n != nil; {
+		if sd.less(k, n.key) {
+			n = n.left
+		} else {
+			best, n = n, n.right
+		}
+	}
+	if best == nil {
+		return nil, false
+	}
+	return best.key.Interface(), true
+}
+
+//Ceiling 获取大于等于给定键的最小键
+func (sd *sortedDictionary) Ceiling(key interface{}) (interface{}, bool) {
+	var k, best = sd.convertTo(key, sd.t.Key()), (*rbnode)(nil)
+	for n := sd.root; n != nil; {
+		if sd.less(n.key, k) {
+			n = n.right
+		} else {
+			best, n = n, n.left
+		}
+	}
+	if best == nil {
+		return nil, false
+	}
+	return best.key.Interface(), true
+}
+
+//Min 获取最小键
+func (sd *sortedDictionary) Min() (interface{}, bool) {
+	if sd.root == nil {
+		return nil, false
+	}
+	var n = sd.root
+	for n.left != nil {
+		n = n.left
+	}
+	return n.key.Interface(), true
+}
+
+//Max 获取最大键
+func (sd *sortedDictionary) Max() (interface{}, bool) {
+	if sd.root == nil {
+		return nil, false
+	}
+	var n = sd.root
+	for n.right != nil {
+		n = n.right
+	}
+	return n.key.Interface(), true
+}
+
+//Rank 获取小于给定键的键的数量
+func (sd *sortedDictionary) Rank(key interface{}) (rank int) {
+	var k = sd.convertTo(key, sd.t.Key())
+	for n := sd.root; n != nil; {
+		if sd.less(k, n.key) {
+			n = n.left
+		} else if sd.less(n.key, k) {
+			rank, n = rank+1+sizeOf(n.left), n.right
+		} else {
+			return rank + sizeOf(n.left)
+		}
+	}
+	return
+}
+
+//Iterator 按顺序遍历键
+func (sd *sortedDictionary) Iterator() Iterator {
+	var nodes, i = sd.nodes(), 0
+	return newIterator(func() (key reflect.Value, ok bool) {
+		if ok = i < len(nodes); ok {
+			key = nodes[i].key
+			i++
+		}
+		return
+	})
+}
+
+func (sd *sortedDictionary) Map(m ...interface{}) interface{} {
+	var dict = newDictionary(sd.t, sizeOf(sd.root))
+	dict.strictness = sd.strictness
+	for _, n := range sd.nodes() {
+		dict.value.SetMapIndex(n.key, n.value)
+	}
+	return dict.Map(m...)
+}
+
+//Keys 按顺序获取键集
+func (sd *sortedDictionary) Keys() List {
+	var nodes = sd.nodes()
+	var keys = newList(reflect.SliceOf(sd.t.Key()), len(nodes))
+	keys.strictness = sd.strictness
+	for index, n := range nodes {
+		keys.value.Index(index).Set(n.key)
+	}
+	return keys
+}
+
+//Values 按键的顺序获取值集
+func (sd *sortedDictionary) Values() List {
+	var nodes = sd.nodes()
+	var values = newList(reflect.SliceOf(sd.t.Elem()), len(nodes))
+	values.strictness = sd.strictness
+	for index, n := range nodes {
+		values.value.Index(index).Set(n.value)
+	}
+	return values
+}
+
+func (sd *sortedDictionary) Where(f interface{}) Dictionary {
+	var function = sd.lambda("Dictionary.Where", f,
+		newFunc(sd.t.Key())(types.Bool)(),
+		newFunc(sd.t.Key(), sd.t.Elem())(types.Bool)(),
+	)
+	var newmap, numin = sd.derive(), function.Type().NumIn()
+	for _, n := range sd.nodes() {
+		var args = []reflect.Value{n.key, n.value}
+		if function.call(args[:numin]...)[0].Bool() {
+			newmap.set(n.key, n.value)
+		}
+	}
+	return newmap
+}
+
+func (sd *sortedDictionary) Count(f ...interface{}) int {
+	if len(f) > 0 {
+		return sd.Where(f[0]).Count()
+	}
+	return sizeOf(sd.root)
+}
+
+//ForEach 按键的顺序遍历
+func (sd *sortedDictionary) ForEach(f interface{}) Dictionary {
+	sd.each("Dictionary.ForEach", f)
+	return sd
+}
+
+//TryForEach 与 ForEach 相同，但遍历函数返回的 error、函数发生的 panic 与签名错误会作为错误返回
+func (sd *sortedDictionary) TryForEach(f interface{}) (err error) {
+	defer recovered("Dictionary.TryForEach", &err)
+	return sd.each("Dictionary.TryForEach", f)
+}
+
+//each 按键的顺序遍历，返回使遍历终止的 error
+func (sd *sortedDictionary) each(operator string, f interface{}) error {
+	var function = sd.lambda(operator, f,
+		newFunc(sd.t.Key())(types.AnyTypes)(),
+		newFunc(sd.t.Key(), sd.t.Elem())(types.AnyTypes)(),
+	)
+	var numin = function.Type().NumIn()
+	for _, n := range sd.nodes() {
+		var args = []reflect.Value{n.key, n.value}
+		if back := function.call(args[:numin]...); stopped(back) {
+			if err := failed(back); err != nil {
+				return throwLambdaFailed(operator, n.key.Interface(), err)
+			}
+			break
+		}
+	}
+	return nil
+}
+
+//Select 映射为新的集合
+//键类型不变时沿用当前比较函数，否则键可比较大小时使用其顺序，均不满足时返回普通 Dictionary。
+func (sd *sortedDictionary) Select(f interface{}) Dictionary {
+	var function = sd.lambda("Dictionary.Select", f,
+		newFunc(sd.t.Key())(types.AnyTypes)(),
+		newFunc(sd.t.Key(), sd.t.Elem())(types.AnyTypes)(),
+	)
+	var funct = function.Type()
+	var kt, vt = sd.t.Key(), funct.Out(0)
+	if funct.NumOut() > 1 {
+		kt, vt = funct.Out(0), funct.Out(1)
+	}
+	var less = sd.less
+	if kt != sd.t.Key() {
+		less = typeLess(kt, kt)
+	}
+	var set func(key, value reflect.Value)
+	var result Dictionary
+	if less == nil {
+		var newmap = newDictionary(reflect.MapOf(kt, vt))
+		newmap.strictness = sd.strictness
+		set, result = newmap.value.SetMapIndex, newmap
+	} else {
+		var newmap = newSortedDictionary(reflect.MapOf(kt, vt), less)
+		newmap.strictness = sd.strictness
+		set, result = newmap.set, newmap
+	}
+	var numin = funct.NumIn()
+	for _, n := range sd.nodes() {
+		var back, key = function.call([]reflect.Value{n.key, n.value}[:numin]...), n.key
+		if len(back) > 1 {
+			key, back[0] = back[0], back[1]
+		}
+		set(key, back[0])
+	}
+	return result
+}
+
+func (sd *sortedDictionary) Merge(d Dictionary, onConflict ...interface{}) Dictionary {
+	if err := typeRequiredWith(sd.strictTypes(), d.Type(), sd.t); err != nil {
+		panic(err)
+	}
+	var function = makeConflictHandler(d.Type().Elem(), 1)
+	if len(onConflict) > 0 {
+		function = sd.lambda("Dictionary.Merge", onConflict[0],
+			newFunc(sd.t.Elem(), types.AnyTypes)(sd.t.Elem())(),
+		)
+	}
+	var newmap = sd.derive()
+	for _, n := range sd.nodes() {
+		newmap.set(n.key, n.value)
+	}
+	d.ForEach(func(k, v interface{}) {
+		var key, value = sd.convertTo(k, sd.t.Key()), sd.convertTo(v, sd.t.Elem())
+		if old := newmap.find(key); old != nil {
+			value = function.call(old.value, value)[0]
+		}
+		newmap.set(key, value)
+	})
+	return newmap
+}
+
+//Invert 交换键与值（结果为普通 Dictionary）
+//如果存在重复的值，那么会抛出 panic 异常。
+func (sd *sortedDictionary) Invert() Dictionary {
+	return invert(sd)
+}
+
+//ToBiMap 转换为双向映射集合
+//如果存在重复的值，那么会抛出 panic 异常。
+func (sd *sortedDictionary) ToBiMap() BiMap {
+	return newBiMap(sd)
+}
+
+//ToImmutable 拷贝为持久化的不可变映射集合（不保留顺序）
+func (sd *sortedDictionary) ToImmutable() ImmutableDictionary {
+	return toImmutableDictionary(sd)
+}
+
+//ToTrie 转换为前缀树
+//如果键不为字符串，那么会抛出 panic 异常。
+func (sd *sortedDictionary) ToTrie() Trie {
+	return toTrie(sd)
+}
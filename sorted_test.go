@@ -0,0 +1,116 @@
+package collections_test
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/johnwiichang/collections"
+)
+
+func TestSortedDictionary(t *testing.T) {
+	var sd = collections.From(map[int]string{}).SortedDictionary()
+	var m = map[int]string{}
+	var random = rand.New(rand.NewSource(1))
+	for i := 0; i < 2000; i++ {
+		var key = random.Intn(300)
+		if random.Intn(3) == 0 {
+			_, existed := m[key]
+			if sd.Remove(key) != existed {
+				t.Fatalf("unexpected remove result for %d", key)
+			}
+			delete(m, key)
+		} else {
+			sd.Set(key, "v")
+			m[key] = "v"
+		}
+	}
+	var keys = collections.From(m).Dictionary().Keys().Sort()
+	if !reflect.DeepEqual(sd.Keys().Slice(), keys.Slice()) || !reflect.DeepEqual(sd.Map(), m) {
+		t.Fatal("tree is not consistent with map")
+	}
+	keys.ForEach(func(i, key int) {
+		if sd.Rank(key) != i {
+			t.Fatalf("unexpected rank of %d", key)
+		}
+	})
+}
+
+func TestSortedDictionaryQuery(t *testing.T) {
+	var sd = collections.From(map[int]bool{10: true, 20: false, 30: true, 40: false}).SortedDictionary()
+	if floor, _ := sd.Floor(25); floor != 20 {
+		t.Fail()
+	}
+	if ceiling, _ := sd.Ceiling(25); ceiling != 30 {
+		t.Fail()
+	}
+	if _, ok := sd.Ceiling(41); ok {
+		t.Fail()
+	}
+	if min, _ := sd.Min(); min != 10 {
+		t.Fail()
+	}
+	if max, _ := sd.Max(); max != 40 {
+		t.Fail()
+	}
+	if !reflect.DeepEqual(sd.Range(15, 40).Keys().Slice(), []int{20, 30, 40}) {
+		t.Fail()
+	}
+	if !reflect.DeepEqual(sd.Where(func(k int, v bool) bool { return v }).Keys().Slice(), []int{10, 30}) {
+		t.Fail()
+	}
+	var keys []int
+	sd.ForEach(func(k int) bool {
+		keys = append(keys, k)
+		return k < 30
+	})
+	if !reflect.DeepEqual(keys, []int{10, 20, 30}) {
+		t.Fail()
+	}
+}
+
+func TestSortedDictionaryComparer(t *testing.T) {
+	var sd = collections.From(map[string]int{"b": 1, "A": 2, "c": 3}).SortedDictionary(strings.ToLower)
+	var keys = sd.Keys().Slice().([]string)
+	if !sort.SliceIsSorted(keys, func(i, j int) bool { return strings.ToLower(keys[i]) < strings.ToLower(keys[j]) }) {
+		t.Fail()
+	}
+	var merged = sd.Merge(collections.From(map[string]int{"a": 4}).Dictionary())
+	if v, _ := merged.(collections.SortedDictionary).Get("A"); v != 4 || merged.Count() != 3 {
+		t.Fail()
+	}
+	var versions = collections.From(map[Version]string{{1, 0}: "b", {0, 1}: "a"}).SortedDictionary()
+	if min, _ := versions.Min(); min != (Version{0, 1}) {
+		t.Fail()
+	}
+	EstimateFail(t, func(*testing.T) {
+		collections.From(map[bool]int{}).SortedDictionary()
+	})
+}
+
+func TestSortedDictionaryRangePruned(t *testing.T) {
+	var compared, prepared = 0, false
+	var sd = collections.From(map[int]bool{}).SortedDictionary(func(a, b int) bool {
+		if prepared {
+			compared++
+		}
+		return a < b
+	})
+	for i := 0; i < 4096; i++ {
+		sd.Set(i, true)
+	}
+	prepared = true
+	var window = sd.Range(1000, 1009)
+	prepared = false
+	if !reflect.DeepEqual(window.Keys().Slice(), []int{1000, 1001, 1002, 1003, 1004, 1005, 1006, 1007, 1008, 1009}) {
+		t.Fail()
+	}
+	if compared > 400 {
+		t.Fatalf("range compared %d keys", compared)
+	}
+	if sd.Range(5000, 6000).Count() != 0 || sd.Range(-10, -1).Count() != 0 {
+		t.Fail()
+	}
+}
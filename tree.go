@@ -0,0 +1,201 @@
+package collections
+
+import (
+	"reflect"
+)
+
+type (
+	//TraverseOptions 层级遍历选项
+	//BreadthFirst 为真时按广度优先顺序输出（默认深度优先先序），MaxDepth 限制子级的最大层数（0 为不限）。
+	TraverseOptions struct {
+		BreadthFirst bool
+		MaxDepth     int
+	}
+
+	//traversal 遍历中的节点，parent 用于环检测
+	traversal struct {
+		value  reflect.Value
+		depth  int
+		parent *traversal
+	}
+)
+
+//cycle 检查节点是否为祖先本身（按指针等引用身份判断），是时返回从祖先到当前节点的环
+//值类型的元素没有身份，相等的值可以合法地重复出现，因此不做检测。
+func (node *traversal) cycle() []reflect.Value {
+	var key, ok = identity(node.value)
+	if !ok {
+		return nil
+	}
+	var path = []reflect.Value{node.value}
+	for ancestor := node.parent; ancestor != nil; ancestor = ancestor.parent {
+		path = append([]reflect.Value{ancestor.value}, path...)
+		if other, ok := identity(ancestor.value); ok && other == key {
+			return path
+		}
+	}
+	return nil
+}
+
+//identity 获取引用类型的值的身份（类型、地址与切片长度），值类型与空引用没有身份
+func identity(v reflect.Value) (interface{}, bool) {
+	if v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Chan, reflect.Func, reflect.UnsafePointer, reflect.Slice:
+		if v.IsNil() {
+			return nil, false
+		}
+		var length int
+		if v.Kind() == reflect.Slice {
+			length = v.Len()
+		}
+		return [3]interface{}{v.Type(), v.Pointer(), length}, true
+	}
+	return nil, false
+}
+
+//traverse 遍历元素及其后代，includeSelf 决定是否输出元素本身
+func (lst *list) traverse(operator string, f interface{}, includeSelf bool, options ...TraverseOptions) List {
+	var function = lst.lambda(operator, f,
+		//支持的函数签名
+		newFunc(lst.t.Elem())(lst.t)(),
+	)
+	var option TraverseOptions
+	if len(options) > 0 {
+		option = options[0]
+	}
+	var newlist = lst.derive(lst.t)
+	var emit = func(node *traversal) {
+		if includeSelf || node.depth > 0 {
+			newlist.value.Set(reflect.Append(*newlist.value, node.value))
+		}
+	}
+	var children = func(node *traversal) []*traversal {
+		if option.MaxDepth > 0 && node.depth >= option.MaxDepth {
+			return nil
+		}
+		var values = function.call(node.value)[0]
+		var nodes = make([]*traversal, values.Len())
+		for i := range nodes {
+			nodes[i] = &traversal{value: values.Index(i).Convert(lst.t.Elem()), depth: node.depth + 1, parent: node}
+			if cycle := nodes[i].cycle(); cycle != nil {
+				var path = lst.derive(lst.t)
+				path.value.Set(reflect.Append(*path.value, cycle...))
+				panic(throwCycleDetected(path.Slice()))
+			}
+		}
+		return nodes
+	}
+	var roots = make([]*traversal, lst.value.Len())
+	for i := range roots {
+		roots[i] = &traversal{value: lst.value.Index(i)}
+	}
+	if option.BreadthFirst {
+		for queue := roots; len(queue) > 0; queue = queue[1:] {
+			emit(queue[0])
+			queue = append(queue, children(queue[0])...)
+		}
+		return newlist
+	}
+	var stack = make([]*traversal, 0, len(roots))
+	for i := len(roots) - 1; i >= 0; i-- {
+		stack = append(stack, roots[i])
+	}
+	for len(stack) > 0 {
+		var node = stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		emit(node)
+		var nodes = children(node)
+		for i := len(nodes) - 1; i >= 0; i-- {
+			stack = append(stack, nodes[i])
+		}
+	}
+	return newlist
+}
+
+//Descendants 递归获取全部元素的后代（不包含元素本身）
+//子级选择函数为 func(T) []T 形式，可以通过 TraverseOptions 选择遍历顺序与最大层数。
+//如果指针等引用类型的元素是自身的祖先，那么会抛出 panic 异常；值类型的元素无法检测环，需要使用 MaxDepth 限制层数。
+func (lst *list) Descendants(f interface{}, options ...TraverseOptions) List {
+	return lst.traverse("List.Descendants", f, false, options...)
+}
+
+//Flatten 递归展开全部元素及其后代
+//与 Descendants 相同，但包含元素本身。
+func (lst *list) Flatten(f interface{}, options ...TraverseOptions) List {
+	return lst.traverse("List.Flatten", f, true, options...)
+}
+
+//BuildTree 根据标识与父级标识将扁平的元素组织为树，返回根元素列表
+//id 与 parent 为 func(T) K 形式，children 为 func(T, []T) 形式的子级设置函数。父级不存在的元素视为根元素。
+//如果标识重复或存在环，那么会抛出 panic 异常。
+func (lst *list) BuildTree(id, parent, children interface{}) List {
+	var elem = lst.t.Elem()
+	var functions = []lambdaFunc{
+		lst.lambda("List.BuildTree", id, newFunc(elem)(types.AnyType)()),
+		lst.lambda("List.BuildTree", parent, newFunc(elem)(types.AnyType)()),
+		lst.lambda("List.BuildTree", children, newFunc(elem, lst.t)()()),
+	}
+	var length = lst.value.Len()
+	var ids, parents = make(map[interface{}]int, length), make([]interface{}, length)
+	for i := 0; i < length; i++ {
+		var key = functions[0].call(lst.value.Index(i))[0].Interface()
+		if _, existed := ids[key]; existed {
+			panic(throwValueIsDuplicated(lst.t, key))
+		}
+		ids[key] = i
+		parents[i] = functions[1].call(lst.value.Index(i))[0].Interface()
+	}
+	var roots, groups = lst.derive(lst.t), make([][]int, length)
+	for i := 0; i < length; i++ {
+		if index, existed := ids[parents[i]]; existed {
+			groups[index] = append(groups[index], i)
+		} else {
+			roots.value.Set(reflect.Append(*roots.value, lst.value.Index(i)))
+		}
+	}
+	var reached = make([]bool, length)
+	var queue []int
+	for i := 0; i < length; i++ {
+		if _, existed := ids[parents[i]]; !existed {
+			queue = append(queue, i)
+		}
+	}
+	for ; len(queue) > 0; queue = queue[1:] {
+		reached[queue[0]] = true
+		queue = append(queue, groups[queue[0]]...)
+	}
+	for i := 0; i < length; i++ {
+		if !reached[i] {
+			panic(throwCycleDetected(lst.ancestry(i, ids, parents).Slice()))
+		}
+	}
+	for i, group := range groups {
+		var items = lst.derive(lst.t, len(group))
+		for index, child := range group {
+			items.value.Index(index).Set(lst.value.Index(child))
+		}
+		functions[2].call(lst.value.Index(i), *items.value)
+	}
+	return roots
+}
+
+//ancestry 沿父级查找从元素出发的环
+func (lst *list) ancestry(i int, ids map[interface{}]int, parents []interface{}) *list {
+	var visited, path = make(map[int]int), []int{}
+	for {
+		if start, existed := visited[i]; existed {
+			path = append(path[start:], i)
+			break
+		}
+		visited[i], path = len(path), append(path, i)
+		i = ids[parents[i]]
+	}
+	var cycle = lst.derive(lst.t, len(path))
+	for index, item := range path {
+		cycle.value.Index(index).Set(lst.value.Index(item))
+	}
+	return cycle
+}
@@ -0,0 +1,93 @@
+package collections_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/johnwiichang/collections"
+)
+
+type Row struct {
+	ID, Parent int
+	Children   []*Row
+}
+
+func rows() collections.List {
+	return collections.From([]*Row{{ID: 1}, {ID: 2, Parent: 1}, {ID: 3, Parent: 1}, {ID: 4, Parent: 2}, {ID: 5}}).List()
+}
+
+func TestBuildTreeAndFlatten(t *testing.T) {
+	var roots = rows().BuildTree(
+		func(r *Row) int { return r.ID },
+		func(r *Row) int { return r.Parent },
+		func(r *Row, children []*Row) { r.Children = children },
+	)
+	var ids = func(l collections.List) interface{} { return l.Select(func(r *Row) int { return r.ID }).Slice() }
+	var children = func(r *Row) []*Row { return r.Children }
+	if !reflect.DeepEqual(ids(roots), []int{1, 5}) {
+		t.Fail()
+	}
+	if !reflect.DeepEqual(ids(roots.Flatten(children)), []int{1, 2, 4, 3, 5}) {
+		t.Fail()
+	}
+	if !reflect.DeepEqual(ids(roots.Flatten(children, collections.TraverseOptions{BreadthFirst: true})), []int{1, 5, 2, 3, 4}) {
+		t.Fail()
+	}
+	if !reflect.DeepEqual(ids(roots.Descendants(children, collections.TraverseOptions{MaxDepth: 1})), []int{2, 3}) {
+		t.Fail()
+	}
+}
+
+func TestTreeCycle(t *testing.T) {
+	var list = rows()
+	list.Slice().([]*Row)[0].Parent = 4
+	var cycle *collections.CycleDetected
+	func() {
+		defer func() {
+			err, _ := recover().(error)
+			errors.As(err, &cycle)
+		}()
+		list.BuildTree(
+			func(r *Row) int { return r.ID },
+			func(r *Row) int { return r.Parent },
+			func(r *Row, children []*Row) { r.Children = children },
+		)
+	}()
+	if cycle == nil || len(cycle.Cycle.([]*Row)) != 4 {
+		t.FailNow()
+	}
+	var a, b = &Row{ID: 1}, &Row{ID: 2}
+	a.Children, b.Children = []*Row{b}, []*Row{a}
+	EstimateFail(t, func(*testing.T) {
+		collections.From([]*Row{a}).List().Flatten(func(r *Row) []*Row { return r.Children })
+	})
+	EstimateFail(t, func(*testing.T) {
+		collections.From([]*Row{a}).List().Flatten(func(r *Row) []int { return nil })
+	})
+	var calls int
+	var repeated = collections.From([]int{1}).List().Flatten(func(n int) []int {
+		if calls++; calls > 3 {
+			return nil
+		}
+		return []int{1}
+	})
+	if !reflect.DeepEqual(repeated.Slice(), []int{1, 1, 1, 1}) {
+		t.Fail()
+	}
+}
+
+func TestBuildTreeDuplicatedID(t *testing.T) {
+	var list = rows()
+	list.Slice().([]*Row)[4].ID = 2
+	var err = recoverError(func() {
+		list.BuildTree(
+			func(r *Row) int { return r.ID },
+			func(r *Row) int { return r.Parent },
+			func(r *Row, children []*Row) { r.Children = children },
+		)
+	})
+	if !errors.Is(err, collections.ErrValueIsDuplicated) {
+		t.Fail()
+	}
+}
@@ -0,0 +1,207 @@
+package collections
+
+import (
+	"reflect"
+	"sort"
+)
+
+type (
+	Trie interface {
+		Insert(key string, value interface{}) Trie
+		Remove(key string) bool
+		Get(key string) (interface{}, bool)
+		HasPrefix(prefix string) bool
+		WithPrefix(prefix string) Dictionary
+		LongestPrefixOf(s string) (string, bool)
+		Len() int
+		Keys() List
+		Iterator() Iterator
+		Dictionary() Dictionary
+
+		Type() reflect.Type
+	}
+
+	trieNode struct {
+		children map[byte]*trieNode
+		value    reflect.Value
+		terminal bool
+	}
+
+	//trie 按字节拆分字符串键的前缀树
+	trie struct {
+		t    reflect.Type
+		root *trieNode
+		size int
+	}
+)
+
+func newTrie(t reflect.Type) *trie {
+	if t.Key().Kind() != reflect.String {
+		panic(throwTypeNotCompatiable("string", t.Key()))
+	}
+	return &trie{t: t, root: &trieNode{}}
+}
+
+//toTrie 将键为字符串的映射集合转换为前缀树
+func toTrie(d Dictionary) *trie {
+	var tr = newTrie(d.Type())
+	d.ForEach(func(k, v interface{}) {
+		tr.insert(convertTo(k, tr.t.Key()).String(), convertTo(v, tr.t.Elem()))
+	})
+	return tr
+}
+
+//find 获取键对应的节点（不存在时返回空）
+func (tr *trie) find(key string) *trieNode {
+	var node = tr.root
+	for i := 0; i < len(key) && node != nil; i++ {
+		node = node.children[key[i]]
+	}
+	return node
+}
+
+func (tr *trie) insert(key string, value reflect.Value) {
+	var node = tr.root
+	for i := 0; i < len(key); i++ {
+		if node.children == nil {
+			node.children = make(map[byte]*trieNode)
+		}
+		var child, existed = node.children[key[i]]
+		if !existed {
+			child = &trieNode{}
+			node.children[key[i]] = child
+		}
+		node = child
+	}
+	if !node.terminal {
+		tr.size++
+	}
+	node.value, node.terminal = value, true
+}
+
+//walk 按字典序遍历节点下的全部键，f 返回 false 时终止
+func (tr *trie) walk(node *trieNode, prefix []byte, f func(key string, node *trieNode) bool) bool {
+	if node.terminal && !f(string(prefix), node) {
+		return false
+	}
+	var labels = make([]int, 0, len(node.children))
+	for label := range node.children {
+		labels = append(labels, int(label))
+	}
+	sort.Ints(labels)
+	for _, label := range labels {
+		if !tr.walk(node.children[byte(label)], append(prefix, byte(label)), f) {
+			return false
+		}
+	}
+	return true
+}
+
+func (tr *trie) key(key string) reflect.Value {
+	return reflect.ValueOf(key).Convert(tr.t.Key())
+}
+
+func (tr *trie) Type() reflect.Type {
+	return tr.t
+}
+
+func (tr *trie) Len() int {
+	return tr.size
+}
+
+func (tr *trie) Insert(key string, value interface{}) Trie {
+	tr.insert(key, convertTo(value, tr.t.Elem()))
+	return tr
+}
+
+//Remove 移除键，同时清理不再使用的节点
+func (tr *trie) Remove(key string) bool {
+	var path = []*trieNode{tr.root}
+	for i := 0; i < len(key); i++ {
+		var child, existed = path[i].children[key[i]]
+		if !existed {
+			return false
+		}
+		path = append(path, child)
+	}
+	var node = path[len(key)]
+	if !node.terminal {
+		return false
+	}
+	node.value, node.terminal = reflect.Value{}, false
+	for i := len(key); i > 0 && !path[i].terminal && len(path[i].children) == 0; i-- {
+		delete(path[i-1].children, key[i-1])
+	}
+	tr.size--
+	return true
+}
+
+func (tr *trie) Get(key string) (interface{}, bool) {
+	if node := tr.find(key); node != nil && node.terminal {
+		return node.value.Interface(), true
+	}
+	return nil, false
+}
+
+//HasPrefix 判断是否存在以给定前缀开头的键
+func (tr *trie) HasPrefix(prefix string) bool {
+	var node = tr.find(prefix)
+	return node != nil && (node.terminal || len(node.children) > 0)
+}
+
+//WithPrefix 获取以给定前缀开头的全部元素（按键排序）
+func (tr *trie) WithPrefix(prefix string) Dictionary {
+	var sd = newSortedDictionary(tr.t, orderedLess(tr.t.Key()))
+	if node := tr.find(prefix); node != nil {
+		tr.walk(node, []byte(prefix), func(key string, node *trieNode) bool {
+			sd.set(tr.key(key), node.value)
+			return true
+		})
+	}
+	return sd
+}
+
+//LongestPrefixOf 获取作为给定字符串前缀的最长键
+func (tr *trie) LongestPrefixOf(s string) (string, bool) {
+	var node, length = tr.root, -1
+	for i := 0; node != nil; i++ {
+		if node.terminal {
+			length = i
+		}
+		if i == len(s) {
+			break
+		}
+		node = node.children[s[i]]
+	}
+	if length < 0 {
+		return "", false
+	}
+	return s[:length], true
+}
+
+//Keys 按字典序获取键集
+func (tr *trie) Keys() List {
+	var keys = newList(reflect.SliceOf(tr.t.Key()))
+	tr.walk(tr.root, nil, func(key string, _ *trieNode) bool {
+		keys.value.Set(reflect.Append(*keys.value, tr.key(key)))
+		return true
+	})
+	return keys
+}
+
+//Iterator 按字典序遍历键
+func (tr *trie) Iterator() Iterator {
+	var keys, index = tr.Keys().(*list).value, 0
+	return newIterator(func() (key reflect.Value, ok bool) {
+		if ok = index < keys.Len(); ok {
+			key = keys.Index(index)
+			index++
+		}
+		return
+	})
+}
+
+//Dictionary 拷贝为按键排序的 Dictionary 集合
+func (tr *trie) Dictionary() Dictionary {
+	return tr.WithPrefix("")
+}
@@ -0,0 +1,54 @@
+package collections_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/johnwiichang/collections"
+)
+
+func TestTrie(t *testing.T) {
+	var trie = collections.From(map[string]int{"/": 0, "/api": 1, "/api/users": 2, "/apple": 3}).Trie()
+	if value, _ := trie.Get("/api"); value != 1 || trie.Len() != 4 {
+		t.Fail()
+	}
+	if !trie.HasPrefix("/ap") || trie.HasPrefix("/b") {
+		t.Fail()
+	}
+	if !reflect.DeepEqual(trie.WithPrefix("/ap").Keys().Slice(), []string{"/api", "/api/users", "/apple"}) {
+		t.Fail()
+	}
+	if prefix, _ := trie.LongestPrefixOf("/api/users/1"); prefix != "/api/users" {
+		t.Fail()
+	}
+	if prefix, _ := trie.LongestPrefixOf("/app"); prefix != "/" {
+		t.Fail()
+	}
+	if !trie.Remove("/api") || trie.Remove("/ap") || !trie.HasPrefix("/api") {
+		t.Fail()
+	}
+	trie.Remove("/api/users")
+	if trie.HasPrefix("/api") || trie.Len() != 2 {
+		t.Fail()
+	}
+	EstimateFail(t, func(*testing.T) {
+		dicts.NumberWithTrue.ToTrie()
+	})
+}
+
+func TestTrieFromList(t *testing.T) {
+	var trie = collections.From([]string{"tea", "ten", "to", "inn"}).Trie().Insert("", false)
+	var keys []string
+	for it := trie.Iterator(); it.Next(); {
+		keys = append(keys, it.Value().(string))
+	}
+	if !reflect.DeepEqual(keys, []string{"", "inn", "tea", "ten", "to"}) {
+		t.Fail()
+	}
+	if prefix, ok := trie.LongestPrefixOf("x"); !ok || prefix != "" {
+		t.Fail()
+	}
+	if trie.Dictionary().Count(func(k string, v bool) bool { return v }) != 4 {
+		t.Fail()
+	}
+}
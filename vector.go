@@ -0,0 +1,191 @@
+package collections
+
+import (
+	"reflect"
+)
+
+const (
+	vectorBits  = 5
+	vectorWidth = 1 << vectorBits
+	vectorMask  = vectorWidth - 1
+)
+
+type (
+	//ImmutableList 持久化列表，修改操作返回新版本，旧版本保持不变
+	//不提供按位置移除（Remove(index)）：向量前缀树只能在末尾以 O(log n) 增删，移除中间元素需要重新追加其后的全部元素（O(n)），
+	//移除最后一个元素请使用 Pop，其他情况可以通过 List() 处理后再调用 ToImmutable() 重建。
+	ImmutableList interface {
+		Get(index int) interface{}
+		Set(index int, element interface{}) ImmutableList
+		Append(elements ...interface{}) ImmutableList
+		Pop() ImmutableList
+		Len() int
+		Iterator() Iterator
+		List() List
+
+		Type() reflect.Type
+	}
+
+	//vectorNode 向量前缀树节点，叶子节点保存元素，内部节点保存子节点
+	vectorNode struct {
+		children []*vectorNode
+		values   []reflect.Value
+	}
+
+	//immutableList 基于 32 叉向量前缀树的持久化列表，修改时仅拷贝根到叶子的路径
+	immutableList struct {
+		t     reflect.Type
+		root  *vectorNode
+		shift uint
+		size  int
+	}
+)
+
+func newImmutableList(t reflect.Type) *immutableList {
+	return &immutableList{t: t, root: &vectorNode{}}
+}
+
+func (node *vectorNode) copy() *vectorNode {
+	if node == nil {
+		return &vectorNode{}
+	}
+	return &vectorNode{
+		children: append([]*vectorNode{}, node.children...),
+		values:   append([]reflect.Value{}, node.values...),
+	}
+}
+
+func (il *immutableList) Type() reflect.Type {
+	return il.t
+}
+
+func (il *immutableList) Len() int {
+	return il.size
+}
+
+func (il *immutableList) check(index int) {
+	if index < 0 || index >= il.size {
+		panic(throwIndexOutOfRange(index, il.size))
+	}
+}
+
+func (il *immutableList) get(index int) reflect.Value {
+	var node = il.root
+	for level := il.shift; level > 0; level -= vectorBits {
+		node = node.children[(index>>level)&vectorMask]
+	}
+	return node.values[index&vectorMask]
+}
+
+func (il *immutableList) Get(index int) interface{} {
+	il.check(index)
+	return il.get(index).Interface()
+}
+
+func set(node *vectorNode, level uint, index int, value reflect.Value) *vectorNode {
+	node = node.copy()
+	if level == 0 {
+		node.values[index&vectorMask] = value
+	} else {
+		var sub = (index >> level) & vectorMask
+		node.children[sub] = set(node.children[sub], level-vectorBits, index, value)
+	}
+	return node
+}
+
+//Set 替换元素并返回新版本
+func (il *immutableList) Set(index int, element interface{}) ImmutableList {
+	il.check(index)
+	var value = convertTo(element, il.t.Elem())
+	return &immutableList{t: il.t, root: set(il.root, il.shift, index, value), shift: il.shift, size: il.size}
+}
+
+func push(node *vectorNode, level uint, index int, value reflect.Value) *vectorNode {
+	node = node.copy()
+	if level == 0 {
+		node.values = append(node.values, value)
+		return node
+	}
+	var sub = (index >> level) & vectorMask
+	if sub < len(node.children) {
+		node.children[sub] = push(node.children[sub], level-vectorBits, index, value)
+	} else {
+		node.children = append(node.children, push(nil, level-vectorBits, index, value))
+	}
+	return node
+}
+
+func (il *immutableList) push(value reflect.Value) *immutableList {
+	var root, shift = il.root, il.shift
+	if il.size == vectorWidth<<shift {
+		root, shift = &vectorNode{children: []*vectorNode{root}}, shift+vectorBits
+	}
+	return &immutableList{t: il.t, root: push(root, shift, il.size, value), shift: shift, size: il.size + 1}
+}
+
+//Append 在末尾追加元素并返回新版本
+func (il *immutableList) Append(elements ...interface{}) ImmutableList {
+	var newlist = il
+	for _, element := range elements {
+		newlist = newlist.push(convertTo(element, il.t.Elem()))
+	}
+	return newlist
+}
+
+func pop(node *vectorNode, level uint, index int) *vectorNode {
+	if level == 0 {
+		if index&vectorMask == 0 {
+			return nil
+		}
+		node = node.copy()
+		node.values = node.values[:len(node.values)-1]
+		return node
+	}
+	var sub = (index >> level) & vectorMask
+	var child = pop(node.children[sub], level-vectorBits, index)
+	if child == nil && sub == 0 {
+		return nil
+	}
+	node = node.copy()
+	if child == nil {
+		node.children = node.children[:sub]
+	} else {
+		node.children[sub] = child
+	}
+	return node
+}
+
+//Pop 移除末尾元素并返回新版本
+func (il *immutableList) Pop() ImmutableList {
+	if il.size == 0 {
+		panic(throwCollectionIsEmpty(il.t))
+	}
+	if il.size == 1 {
+		return newImmutableList(il.t)
+	}
+	var root, shift = pop(il.root, il.shift, il.size-1), il.shift
+	if shift > 0 && len(root.children) == 1 {
+		root, shift = root.children[0], shift-vectorBits
+	}
+	return &immutableList{t: il.t, root: root, shift: shift, size: il.size - 1}
+}
+
+func (il *immutableList) Iterator() Iterator {
+	var index int
+	return newIterator(func() (value reflect.Value, ok bool) {
+		if ok = index < il.size; ok {
+			value = il.get(index)
+			index++
+		}
+		return
+	})
+}
+
+//List 拷贝为可变的 List 集合
+func (il *immutableList) List() List {
+	var newlist = newList(il.t, il.size)
+	for i := 0; i < il.size; i++ {
+		newlist.value.Index(i).Set(il.get(i))
+	}
+	return newlist
+}